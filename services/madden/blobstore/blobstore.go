@@ -0,0 +1,27 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+//ErrBlobExists is returned by Put when a blob is already stored under the name
+var ErrBlobExists = errors.New("blob already exists")
+
+//BlobInfo describes a stored blob
+type BlobInfo struct {
+	Name       string
+	ModifiedAt time.Time
+}
+
+//BlobStore defines an interface to the storage of image content served from IMAGE_PATH
+type BlobStore interface {
+	//Put stores content under name, returning ErrBlobExists and leaving the stored blob untouched if the name is taken
+	Put(ctx context.Context, name string, content io.Reader) error
+	//Delete removes the blob stored under name, removing a blob that does not exist is not an error
+	Delete(ctx context.Context, name string) error
+	//List returns every stored blob ordered by name
+	List(ctx context.Context) ([]BlobInfo, error)
+}
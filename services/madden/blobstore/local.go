@@ -0,0 +1,119 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//prefix of the files blobs are written to before they are linked under their name, never a valid blob name
+const TEMP_PREFIX = ".put-"
+
+//localBlobStore stores blobs as files in a single directory
+type localBlobStore struct {
+	directory string
+}
+
+//NewLocalBlobStore returns a BlobStore keeping blobs in directory, the directory is created if it does not exist
+func NewLocalBlobStore(directory string) (BlobStore, error) {
+	if directory == "" {
+		return nil, fmt.Errorf("local blob store directory is required")
+	}
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return nil, fmt.Errorf("unable to create local blob store directory %s: %w", directory, err)
+	}
+	return &localBlobStore{directory: directory}, nil
+}
+
+func (store *localBlobStore) Put(ctx context.Context, name string, content io.Reader) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	path, err := store.path(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return ErrBlobExists
+	}
+	//written in full under a temporary name first, so a blob is never seen under its name until it is complete
+	file, err := ioutil.TempFile(store.directory, TEMP_PREFIX+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	//linking fails if the name is taken, so of two puts of the same name the first to finish wins and the other leaves it untouched
+	if err := os.Link(file.Name(), path); err != nil {
+		if os.IsExist(err) {
+			return ErrBlobExists
+		}
+		return err
+	}
+	return syncDirectory(store.directory)
+}
+
+func (store *localBlobStore) Delete(ctx context.Context, name string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	path, err := store.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (store *localBlobStore) List(ctx context.Context) ([]BlobInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	entries, err := ioutil.ReadDir(store.directory)
+	if err != nil {
+		return nil, err
+	}
+	blobs := []BlobInfo{}
+	for _, entry := range entries {
+		//blobs still being written, or left behind by a put that never finished
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), TEMP_PREFIX) {
+			continue
+		}
+		blobs = append(blobs, BlobInfo{Name: entry.Name(), ModifiedAt: entry.ModTime()})
+	}
+	return blobs, nil
+}
+
+//syncDirectory flushes the entries of directory so a linked blob survives a crash
+func syncDirectory(directory string) error {
+	dir, err := os.Open(directory)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+//path returns the file path of the blob name, refusing names that would leave the store directory
+func (store *localBlobStore) path(name string) (string, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, TEMP_PREFIX) {
+		return "", fmt.Errorf("invalid blob name %q", name)
+	}
+	return filepath.Join(store.directory, name), nil
+}
@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+//response cache handlers
+
+func (handler *maddenHandler) GetCache(ctx echo.Context) error {
+	stats, err := handler.dataservice.GetCacheStats(ctx.Request().Context())
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, stats)
+}
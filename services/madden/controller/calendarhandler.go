@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/labstack/echo/v4"
+)
+
+//calendar feed handler
+
+const (
+	CALENDAR_CONTENT_TYPE = "text/calendar; charset=utf-8"
+)
+
+func (handler *maddenHandler) GetCalendarIcs(ctx echo.Context, params swagger.GetCalendarIcsParams) error {
+	//the feed is filtered exactly as GET /entry is
+	entryParams := swagger.GetEntryParams{StartDate: params.StartDate, EndDate: params.EndDate}
+	if params.Historic != nil {
+		entryParams.Historic = entryParamHistoricPtr(swagger.GetEntryParamsHistoric(*params.Historic))
+	}
+	filledParams := fillParamDefaults(entryParams)
+	if !paramsValid(filledParams) {
+		return ctx.JSON(http.StatusBadRequest, swagger.Error{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid parameters",
+		})
+	}
+	calendar, err := handler.dataservice.GetCalendar(ctx.Request().Context(), filledParams)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.Blob(http.StatusOK, CALENDAR_CONTENT_TYPE, calendar)
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/labstack/echo/v4"
+)
+
+//conditional responses of reads polled by status pages
+
+const (
+	IF_NONE_MATCH_HEADER     = "If-None-Match"
+	IF_MODIFIED_SINCE_HEADER = "If-Modified-Since"
+	LAST_MODIFIED_HEADER     = "Last-Modified"
+	//hex characters of the body digest kept in a content entity tag
+	CONTENT_TAG_LENGTH = 32
+)
+
+//writeConditionalJSON writes value as json with the ETag tag and, unless lastModified is zero, a Last-Modified header
+//an empty tag is replaced by a strong ETag of the body, a request whose validators show it already holds the body is answered with 304 and no body
+func writeConditionalJSON(ctx echo.Context, value interface{}, tag string, lastModified time.Time) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if tag == "" {
+		tag = contentTag(body)
+	}
+	header := ctx.Response().Header()
+	header.Set(ETAG_HEADER, tag)
+	if !lastModified.IsZero() {
+		header.Set(LAST_MODIFIED_HEADER, lastModified.UTC().Format(http.TimeFormat))
+	}
+	if notModified(ctx.Request(), tag, lastModified) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+	return ctx.JSONBlob(http.StatusOK, body)
+}
+
+//contentTag returns a strong entity tag of body
+func contentTag(body []byte) string {
+	digest := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(digest[:])[:CONTENT_TAG_LENGTH] + `"`
+}
+
+//notModified returns true if the validators of request match tag or lastModified
+//If-Modified-Since is only considered without If-None-Match and with a lastModified
+func notModified(request *http.Request, tag string, lastModified time.Time) bool {
+	if ifNoneMatch := request.Header.Get(IF_NONE_MATCH_HEADER); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			//If-None-Match compares weakly, so a weak form of the tag matches as well
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == tag {
+				return true
+			}
+		}
+		return false
+	}
+	ifModifiedSince := request.Header.Get(IF_MODIFIED_SINCE_HEADER)
+	if ifModifiedSince == "" || lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	//Last-Modified is only precise to the second
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+//newestUpdate returns the latest of the RFC3339 times of changes, zero if there are none
+func newestUpdate(updates ...*string) time.Time {
+	newest := time.Time{}
+	for _, update := range updates {
+		if update == nil {
+			continue
+		}
+		if updatedAt, err := time.Parse(time.RFC3339, *update); err == nil && updatedAt.After(newest) {
+			newest = updatedAt
+		}
+	}
+	return newest
+}
+
+//entriesLastModified returns the time of the latest change to any of entries
+func entriesLastModified(entries []swagger.MaddenItem) time.Time {
+	updates := []*string{}
+	for _, entry := range entries {
+		updates = append(updates, entry.UpdatedAt)
+	}
+	return newestUpdate(updates...)
+}
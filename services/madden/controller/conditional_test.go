@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestNotModified(t *testing.T) {
+	tag := `"abc"`
+	lastModified := time.Date(2026, 3, 4, 5, 6, 7, 500000000, time.UTC)
+	tests := []struct {
+		name            string
+		ifNoneMatch     string
+		ifModifiedSince string
+		lastModified    time.Time
+		expected        bool
+	}{
+		{name: "no validators", lastModified: lastModified, expected: false},
+		{name: "matching tag", ifNoneMatch: `"abc"`, expected: true},
+		{name: "other tag", ifNoneMatch: `"def"`, expected: false},
+		{name: "tag in a list", ifNoneMatch: `"def", "abc"`, expected: true},
+		{name: "tag in a list without spaces", ifNoneMatch: `"def","abc"`, expected: true},
+		{name: "weak tag", ifNoneMatch: `W/"abc"`, expected: true},
+		{name: "weak tag in a list", ifNoneMatch: `"def", W/"abc"`, expected: true},
+		{name: "any tag", ifNoneMatch: `*`, expected: true},
+		{name: "unquoted tag", ifNoneMatch: `abc`, expected: false},
+		{name: "modified since an earlier time", ifModifiedSince: "Wed, 04 Mar 2026 05:06:06 GMT", lastModified: lastModified, expected: false},
+		//Last-Modified is written to the second, so the fraction is not a modification
+		{name: "not modified since the same second", ifModifiedSince: "Wed, 04 Mar 2026 05:06:07 GMT", lastModified: lastModified, expected: true},
+		{name: "not modified since a later time", ifModifiedSince: "Wed, 04 Mar 2026 06:00:00 GMT", lastModified: lastModified, expected: true},
+		{name: "unparseable time", ifModifiedSince: "yesterday", lastModified: lastModified, expected: false},
+		{name: "no modification time", ifModifiedSince: "Wed, 04 Mar 2026 06:00:00 GMT", expected: false},
+		//If-None-Match takes precedence over If-Modified-Since either way
+		{name: "other tag with an unmodified time", ifNoneMatch: `"def"`, ifModifiedSince: "Wed, 04 Mar 2026 06:00:00 GMT", lastModified: lastModified, expected: false},
+		{name: "matching tag with a modified time", ifNoneMatch: `"abc"`, ifModifiedSince: "Wed, 04 Mar 2026 05:00:00 GMT", lastModified: lastModified, expected: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/entry", nil)
+			if test.ifNoneMatch != "" {
+				request.Header.Set(IF_NONE_MATCH_HEADER, test.ifNoneMatch)
+			}
+			if test.ifModifiedSince != "" {
+				request.Header.Set(IF_MODIFIED_SINCE_HEADER, test.ifModifiedSince)
+			}
+			if modified := notModified(request, tag, test.lastModified); modified != test.expected {
+				t.Errorf("expected not modified %t got %t\n", test.expected, modified)
+			}
+		})
+	}
+}
+
+func TestWriteConditionalJSON(t *testing.T) {
+	value := map[string]string{"summary": "all systems"}
+	lastModified := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	first := httptest.NewRecorder()
+	if err := writeConditionalJSON(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/summary", nil), first), value, "", lastModified); err != nil {
+		t.Fatalf("unable to write response ERROR: %s\n", err.Error())
+	}
+	contentETag := first.Header().Get(ETAG_HEADER)
+	if first.Code != http.StatusOK || contentETag == "" || first.Body.Len() == 0 {
+		t.Fatalf("expected 200 with an ETag and a body got %d with ETag %q\n", first.Code, contentETag)
+	}
+	if header := first.Header().Get(LAST_MODIFIED_HEADER); header != "Wed, 04 Mar 2026 05:06:07 GMT" {
+		t.Errorf("expected Last-Modified of the modification time got %q\n", header)
+	}
+	tests := []struct {
+		name           string
+		tag            string
+		lastModified   time.Time
+		ifNoneMatch    string
+		expectedStatus int
+		expectedTag    string
+	}{
+		{name: "same body", ifNoneMatch: contentETag, lastModified: lastModified, expectedStatus: http.StatusNotModified, expectedTag: contentETag},
+		{name: "other body", ifNoneMatch: `"other"`, lastModified: lastModified, expectedStatus: http.StatusOK, expectedTag: contentETag},
+		{name: "version tag", tag: `"3"`, ifNoneMatch: `"3"`, expectedStatus: http.StatusNotModified, expectedTag: `"3"`},
+		{name: "stale version tag", tag: `"4"`, ifNoneMatch: `"3"`, expectedStatus: http.StatusOK, expectedTag: `"4"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/summary", nil)
+			request.Header.Set(IF_NONE_MATCH_HEADER, test.ifNoneMatch)
+			recorder := httptest.NewRecorder()
+			if err := writeConditionalJSON(echo.New().NewContext(request, recorder), value, test.tag, test.lastModified); err != nil {
+				t.Fatalf("unable to write response ERROR: %s\n", err.Error())
+			}
+			if recorder.Code != test.expectedStatus {
+				t.Errorf("expected %d got %d\n", test.expectedStatus, recorder.Code)
+			}
+			if tag := recorder.Header().Get(ETAG_HEADER); tag != test.expectedTag {
+				t.Errorf("expected ETag %s got %s\n", test.expectedTag, tag)
+			}
+			if test.expectedStatus == http.StatusNotModified && recorder.Body.Len() != 0 {
+				t.Errorf("expected no body with 304 got %q\n", recorder.Body.String())
+			}
+			if test.lastModified.IsZero() && recorder.Header().Get(LAST_MODIFIED_HEADER) != "" {
+				t.Errorf("expected no Last-Modified without a modification time\n")
+			}
+		})
+	}
+}
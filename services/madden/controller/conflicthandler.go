@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+	"github.com/labstack/echo/v4"
+)
+
+//entry conflict handlers
+
+const (
+	CONFLICT_RANGE_DEFAULT = 90 * 24 * time.Hour
+	//recurring entries are expanded across the whole range, so it is kept to a span people plan maintenance over
+	MAXIMUM_CONFLICT_RANGE = 2 * 366 * 24 * time.Hour
+)
+
+func (handler *maddenHandler) GetConflicts(ctx echo.Context, params swagger.GetConflictsParams) error {
+	params = fillConflictDefaults(params, time.Now())
+	if err := conflictParamsValid(params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	conflicts, err := handler.dataservice.GetConflicts(ctx.Request().Context(), params)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, conflicts)
+}
+
+//fillConflictDefaults fills a missing from with now and a missing to with CONFLICT_RANGE_DEFAULT after from
+//an invalid from is left for conflictParamsValid to reject
+func fillConflictDefaults(params swagger.GetConflictsParams, now time.Time) swagger.GetConflictsParams {
+	if params.From == nil {
+		params.From = utilities.StrPtr(now.UTC().Truncate(time.Second).Format(time.RFC3339))
+	}
+	if params.To == nil {
+		if from, err := time.Parse(time.RFC3339, *params.From); err == nil {
+			params.To = utilities.StrPtr(from.Add(CONFLICT_RANGE_DEFAULT).UTC().Format(time.RFC3339))
+		}
+	}
+	return params
+}
+
+//conflictParamsValid ensures the range of a conflict listing is valid and no longer than MAXIMUM_CONFLICT_RANGE
+func conflictParamsValid(params swagger.GetConflictsParams) error {
+	from, err := time.Parse(time.RFC3339, *params.From)
+	if err != nil {
+		return fmt.Errorf("time format of from was not valid, expect RFC3339")
+	}
+	if params.To == nil {
+		return fmt.Errorf("time format of to was not valid, expect RFC3339")
+	}
+	to, err := time.Parse(time.RFC3339, *params.To)
+	if err != nil {
+		return fmt.Errorf("time format of to was not valid, expect RFC3339")
+	}
+	if !to.After(from) {
+		return fmt.Errorf("to must be after from")
+	}
+	if to.Sub(from) > MAXIMUM_CONFLICT_RANGE {
+		return fmt.Errorf("the range between from and to must be at most %d days", int(MAXIMUM_CONFLICT_RANGE/(24*time.Hour)))
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+//per route class request deadlines, the deadline is carried by the request context down to the data store
+
+//streams stay open until the client disconnects and exports grow with the data store, so neither are bounded
+var unboundedPaths = map[string]bool{
+	"/events": true,
+	"/export": true,
+}
+
+//RequestDeadlines returns middleware bounding each request, reads by read and writes by write, a duration of 0 sets no deadline
+func RequestDeadlines(read, write time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if unboundedPaths[ctx.Request().URL.Path] {
+				return next(ctx)
+			}
+			timeout := write
+			if isReadRequest(ctx.Request()) {
+				timeout = read
+			}
+			if timeout <= 0 {
+				return next(ctx)
+			}
+			bounded, cancel := context.WithTimeout(ctx.Request().Context(), timeout)
+			defer cancel()
+			ctx.SetRequest(ctx.Request().WithContext(bounded))
+			return next(ctx)
+		}
+	}
+}
+
+//isReadRequest reports if request belongs to the read route class
+func isReadRequest(request *http.Request) bool {
+	return request.Method == http.MethodGet || request.Method == http.MethodHead
+}
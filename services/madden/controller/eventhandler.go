@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/labstack/echo/v4"
+)
+
+//server-sent events handler
+
+const (
+	EVENT_STREAM_CONTENT_TYPE = "text/event-stream"
+	//comment lines are written while no events are, so proxies do not close an idle stream
+	EVENT_KEEPALIVE_INTERVAL = 15 * time.Second
+	//how long a disconnected client waits before reconnecting
+	EVENT_RETRY_MILLISECONDS = 3000
+)
+
+func (handler *maddenHandler) GetEvents(ctx echo.Context, params swagger.GetEventsParams) error {
+	var lastEventId *uint
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		id, err := strconv.ParseUint(*params.LastEventID, 10, 64)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Last-Event-ID must be the id of an event",
+			})
+		}
+		converted := uint(id)
+		lastEventId = &converted
+	}
+	stream, err := handler.dataservice.SubscribeEvents(ctx.Request().Context(), lastEventId)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, EVENT_STREAM_CONTENT_TYPE)
+	response.Header().Set("Cache-Control", "no-cache")
+	//stops buffering proxies such as nginx holding events back
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprintf(response, "retry: %d\n\n", EVENT_RETRY_MILLISECONDS); err != nil {
+		return nil
+	}
+	response.Flush()
+	keepalive := time.NewTicker(EVENT_KEEPALIVE_INTERVAL)
+	defer keepalive.Stop()
+	for {
+		var err error
+		select {
+		case event, ok := <-stream:
+			if !ok {
+				//the client disconnected or fell too far behind, a client reconnecting with Last-Event-ID resumes
+				return nil
+			}
+			//payloads are single line json so each is one data line
+			_, err = fmt.Fprintf(response, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Event, event.Data)
+		case <-keepalive.C:
+			_, err = fmt.Fprint(response, ": keepalive\n\n")
+		}
+		if err != nil {
+			return nil
+		}
+		response.Flush()
+	}
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/dataservice"
+	"github.com/labstack/echo/v4"
+)
+
+//rss and atom feed handlers
+
+const (
+	RSS_CONTENT_TYPE  = "application/rss+xml; charset=utf-8"
+	ATOM_CONTENT_TYPE = "application/atom+xml; charset=utf-8"
+)
+
+func (handler *maddenHandler) GetFeedRss(ctx echo.Context) error {
+	return handler.writeFeed(ctx, dataservice.FEED_RSS, RSS_CONTENT_TYPE)
+}
+
+func (handler *maddenHandler) GetFeedAtom(ctx echo.Context) error {
+	return handler.writeFeed(ctx, dataservice.FEED_ATOM, ATOM_CONTENT_TYPE)
+}
+
+//writeFeed responds with the feed in format, announcing the url it was requested from as its own
+func (handler *maddenHandler) writeFeed(ctx echo.Context, format, contentType string) error {
+	selfLink := ctx.Scheme() + "://" + ctx.Request().Host + ctx.Request().URL.Path
+	feed, err := handler.dataservice.GetFeed(ctx.Request().Context(), format, selfLink)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.Blob(http.StatusOK, contentType, feed)
+}
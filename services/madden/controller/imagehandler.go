@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+	"github.com/labstack/echo/v4"
+)
+
+//image library handlers
+
+const (
+	//matches the size of the image file name columns
+	MAXIMUM_IMAGE_NAME_LENGTH = 500
+	//room for multipart framing on top of the largest accepted image
+	MULTIPART_OVERHEAD_BYTES = 1 << 16
+	UPLOAD_FILE_FIELD        = "file"
+)
+
+func (handler *maddenHandler) GetImage(ctx echo.Context, params swagger.GetImageParams) error {
+	if params.Cursor != nil && params.PageNumber != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "cursor and pageNumber can not be combined",
+		})
+	}
+	if params.Cursor != nil && params.Name != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "name results are paged by pageNumber, cursor can not be combined with name",
+		})
+	}
+	if params.PageNumber == nil {
+		params.PageNumber = utilities.IntPtr(PAGE_NUMBER_DEFAULT)
+	}
+	if params.PageSize == nil {
+		params.PageSize = utilities.IntPtr(PAGE_SIZE_DEFAULT)
+	}
+	if *params.PageNumber < 0 || *params.PageSize < 0 {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid parameters",
+		})
+	}
+	page, err := handler.dataservice.GetImages(ctx.Request().Context(), params)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, page)
+}
+
+func (handler *maddenHandler) PostImage(ctx echo.Context) error {
+	imageBody := swagger.ImageFile{}
+	if err := ctx.Bind(&imageBody); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if err := imageFileValid(imageBody); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	created, err := handler.dataservice.CreateImage(ctx.Request().Context(), imageBody)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	setVersionTag(ctx, created.Version)
+	return ctx.JSON(http.StatusCreated, created)
+}
+
+func (handler *maddenHandler) PutImageImageId(ctx echo.Context, imageId int) error {
+	imageBody := swagger.ImageFile{}
+	if err := ctx.Bind(&imageBody); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if err := updateImageValid(imageBody, imageId); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	expectedVersion, err := expectedVersionFromRequest(ctx)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	updated, err := handler.dataservice.UpdateImage(ctx.Request().Context(), imageBody, expectedVersion)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	setVersionTag(ctx, updated.Version)
+	return ctx.JSON(http.StatusOK, updated)
+}
+
+func (handler *maddenHandler) DeleteImageImageId(ctx echo.Context, imageId int, params swagger.DeleteImageImageIdParams) error {
+	if imageId < 0 {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "image id was invalid must be positive integer",
+		})
+	}
+	cascade := params.Cascade != nil && *params.Cascade
+	if err := handler.dataservice.DeleteImage(ctx.Request().Context(), imageId, cascade, actorFromRequest(ctx)); err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler *maddenHandler) GetImageImageIdUsages(ctx echo.Context, imageId int) error {
+	if imageId < 0 {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "image id was invalid must be positive integer",
+		})
+	}
+	usages, err := handler.dataservice.GetImageUsages(ctx.Request().Context(), imageId)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, usages)
+}
+
+func (handler *maddenHandler) PostImageUpload(ctx echo.Context) error {
+	request := ctx.Request()
+	if request.ContentLength > handler.maxUploadBytes+MULTIPART_OVERHEAD_BYTES {
+		return uploadTooLarge(ctx, handler.maxUploadBytes)
+	}
+	request.Body = http.MaxBytesReader(ctx.Response(), request.Body, handler.maxUploadBytes+MULTIPART_OVERHEAD_BYTES)
+	header, err := ctx.FormFile(UPLOAD_FILE_FIELD)
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			return uploadTooLarge(ctx, handler.maxUploadBytes)
+		}
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: fmt.Sprintf("a multipart form holding the image in the %s field is required", UPLOAD_FILE_FIELD),
+		})
+	}
+	if header.Size > handler.maxUploadBytes {
+		return uploadTooLarge(ctx, handler.maxUploadBytes)
+	}
+	file, err := header.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, swagger.ErrorResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to read uploaded image",
+		})
+	}
+	defer file.Close()
+	image, created, err := handler.dataservice.UploadImage(request.Context(), file)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	setVersionTag(ctx, image.Version)
+	if !created {
+		return ctx.JSON(http.StatusOK, image)
+	}
+	return ctx.JSON(http.StatusCreated, image)
+}
+
+//uploadTooLarge writes the response to an upload larger than maxBytes
+func uploadTooLarge(ctx echo.Context, maxBytes int64) error {
+	return ctx.JSON(http.StatusRequestEntityTooLarge, swagger.ErrorResponse{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: fmt.Sprintf("images must be no more than %d bytes", maxBytes),
+	})
+}
+
+//imageFileValid returns an error with detailed message if the passed image file is invalid
+func imageFileValid(image swagger.ImageFile) error {
+	if image.FileName == "" || image.Thumbnail == "" {
+		return fmt.Errorf("image fileName and thumbnail are required")
+	}
+	if len(image.FileName) > MAXIMUM_IMAGE_NAME_LENGTH || len(image.Thumbnail) > MAXIMUM_IMAGE_NAME_LENGTH {
+		return fmt.Errorf("image fileName and thumbnail must be no more than %d in length", MAXIMUM_IMAGE_NAME_LENGTH)
+	}
+	return nil
+}
+
+//updateImageValid ensures an updated image is valid and matches the image id of the path
+func updateImageValid(image swagger.ImageFile, id int) error {
+	if err := imageFileValid(image); err != nil {
+		return err
+	}
+	if image.Id != id {
+		return fmt.Errorf("path id must match id of image in body")
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+//background job handlers
+
+func (handler *maddenHandler) GetHistoricize(ctx echo.Context) error {
+	run, err := handler.dataservice.GetHistoricizeRun(ctx.Request().Context())
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, run)
+}
@@ -1,13 +1,24 @@
 package controller
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/PurplWarrior22/TestingCode/services/madden/dataservice"
 	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
 	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/models"
 )
 
 type maddenHandler struct {
 	dataservice dataservice.MaddenDataService
+	//the largest image accepted by the upload endpoint in bytes
+	maxUploadBytes int64
+	//the largest body accepted by the import endpoint in bytes
+	maxImportBytes int64
+	//refuse entries conflicting with others rather than returning the conflicts as warnings
+	strictConflicts bool
 }
 
 const (
@@ -18,23 +29,29 @@ const (
 	PAGE_SIZE_DEFAULT                                     = 25
 	DEFAULT_SORT           swagger.GetEntryParamsSort     = "startDate"
 	DEFAULT_HISTORIC       swagger.GetEntryParamsHistoric = "historic"
+	//header set by the authenticating proxy, identifies who made a change
+	ACTOR_HEADER  = "X-Forwarded-User"
+	DEFAULT_ACTOR = "anonymous"
+	//entity tags carry the entry version, updates must send the tag they replace in If-Match
+	ETAG_HEADER     = "ETag"
+	IF_MATCH_HEADER = "If-Match"
 )
 
 //constructor
 
-func NewMaddenServerHandler(dataservice dataservice.MaddenDataService) swagger.ServerInterface {
-	return &maddenHandler{dataservice: dataservice}
+func NewMaddenServerHandler(dataservice dataservice.MaddenDataService, maxUploadBytes, maxImportBytes int64, strictConflicts bool) swagger.ServerInterface {
+	return &maddenHandler{dataservice: dataservice, maxUploadBytes: maxUploadBytes, maxImportBytes: maxImportBytes, strictConflicts: strictConflicts}
 }
 
 func (handler *maddenHandler) GetSummary(ctx echo.Context) error {
-	summary, err := handler.dataservice.GetSummary()
+	summary, err := handler.dataservice.GetSummary(ctx.Request().Context())
 	if err != nil {
 		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
 			Code:    utilities.StatusCodeError(err),
 			Message: err.Error(),
 		})
 	}
-	return ctx.JSON(http.StatusOK, summary)
+	return writeConditionalJSON(ctx, summary, "", newestUpdate(summary.UpdatedAt))
 }
 
 func (handler *maddenHandler) PostSummary(ctx echo.Context) error {
@@ -53,7 +70,7 @@ func (handler *maddenHandler) PostSummary(ctx echo.Context) error {
 			Message: err.Error(),
 		})
 	}
-	created, err := handler.dataservice.CreateSummary(summary)
+	created, err := handler.dataservice.CreateSummary(ctx.Request().Context(), summary)
 	if err != nil {
 		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
 			Code:    utilities.StatusCodeError(err),
@@ -64,14 +81,14 @@ func (handler *maddenHandler) PostSummary(ctx echo.Context) error {
 }
 
 func (handler *maddenHandler) GetPublished(ctx echo.Context) error {
-	published, err := handler.dataservice.GetPublished()
+	published, err := handler.dataservice.GetPublished(ctx.Request().Context())
 	if err != nil {
 		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
 			Code:    utilities.StatusCodeError(err),
 			Message: err.Error(),
 		})
 	}
-	return ctx.JSON(http.StatusOK, published)
+	return writeConditionalJSON(ctx, published, "", newestUpdate(published.UpdatedAt))
 }
 
 func (handler *maddenHandler) PostPublished(ctx echo.Context) error {
@@ -84,7 +101,7 @@ func (handler *maddenHandler) PostPublished(ctx echo.Context) error {
 			Message: "unable to read request body",
 		})
 	}
-	created, err := handler.dataservice.CreatePublished(published)
+	created, err := handler.dataservice.CreatePublished(ctx.Request().Context(), published)
 	if err != nil {
 		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
 			Code:    utilities.StatusCodeError(err),
@@ -102,23 +119,41 @@ func (handler *maddenHandler) GetEntry(ctx echo.Context, params swagger.GetEntry
 			Message: "Invalid parameters",
 		})
 	}
-	items := []swagger.MaddenItem{}
-	var err error
+	if params.Cursor != nil && params.PageNumber != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.Error{
+			Code:    http.StatusBadRequest,
+			Message: "cursor and pageNumber can not be combined",
+		})
+	}
+	if params.Q != nil && params.Cursor != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.Error{
+			Code:    http.StatusBadRequest,
+			Message: "search results are paged by pageNumber, cursor can not be combined with q",
+		})
+	}
 	if params.Id != nil {
-		items, err = handler.getSingleItem(params)
-	} else {
-		items, err = handler.dataservice.GetMaddenEntries(filledParams)
+		return handler.getSingleItem(ctx, params)
 	}
-
+	//read before the page so a write landing in between can only make the page newer than Last-Modified
+	lastModified, err := handler.dataservice.GetEntriesLastModified(ctx.Request().Context())
 	if err != nil {
-		ctx.JSON(utilities.StatusCodeError(err), swagger.Error{
+		return ctx.JSON(utilities.StatusCodeError(err), swagger.Error{
 			Code:    utilities.StatusCodeError(err),
 			Message: err.Error(),
 		})
 	}
-	return ctx.JSON(http.StatusOK, swagger.MaddenItems{
-		Entries: items,
-	})
+	page, err := handler.dataservice.GetMaddenEntries(ctx.Request().Context(), filledParams)
+	if err != nil {
+		return ctx.JSON(utilities.StatusCodeError(err), swagger.Error{
+			Code:    utilities.StatusCodeError(err),
+			Message: err.Error(),
+		})
+	}
+	//an entry leaving the page changes it without updating anything in it, so the latest change to any entry counts as well
+	if newest := entriesLastModified(page.Entries); newest.After(lastModified) {
+		lastModified = newest
+	}
+	return writeConditionalJSON(ctx, page, "", lastModified)
 }
 
 func (handler *maddenHandler) PostEntry(ctx echo.Context) error {
@@ -135,13 +170,11 @@ func (handler *maddenHandler) PostEntry(ctx echo.Context) error {
 			Message: err.Error(),
 		})
 	}
-	created, err := handler.dataservice.CreateEntry(itemBody)
+	created, err := handler.dataservice.CreateEntry(ctx.Request().Context(), itemBody, handler.strictConflicts, actorFromRequest(ctx))
 	if err != nil {
-		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
-			Code:    utilities.StatusCodeError(err),
-			Message: err.Error(),
-		})
+		return writeErrorResponse(ctx, err)
 	}
+	setEntityTag(ctx, created)
 	return ctx.JSON(http.StatusCreated, created)
 }
 
@@ -159,42 +192,221 @@ func (handler *maddenHandler) PutEntryMaddenId(ctx echo.Context, maddenId int) e
 			Message: err.Error(),
 		})
 	}
-	updated, err := handler.dataservice.UpdateEntry(itemBody)
+	expectedVersion, err := expectedVersionFromRequest(ctx)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	updated, err := handler.dataservice.UpdateEntry(ctx.Request().Context(), itemBody, expectedVersion, handler.strictConflicts, actorFromRequest(ctx))
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	setEntityTag(ctx, updated)
+	return ctx.JSON(http.StatusCreated, updated)
+}
+
+func (handler *maddenHandler) DeleteEntryMaddenId(ctx echo.Context, maddenId int) error {
+	if err := deleteEntryValid(maddenId); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	err := handler.dataservice.DeleteEntry(ctx.Request().Context(), maddenId, actorFromRequest(ctx))
 	if err != nil {
 		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
 			Code:    utilities.StatusCodeError(err),
 			Message: err.Error(),
 		})
 	}
-	return ctx.JSON(http.StatusCreated, updated)
+	return ctx.NoContent(http.StatusNoContent)
 }
 
-func (handler *maddenHandler) DeleteEntryMaddenId(ctx echo.Context, maddenId int) error {
+func (handler *maddenHandler) GetEntryMaddenIdHistory(ctx echo.Context, maddenId int) error {
 	if err := deleteEntryValid(maddenId); err != nil {
 		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
 			Code:    http.StatusBadRequest,
 			Message: err.Error(),
 		})
 	}
-	err := handler.dataservice.DeleteEntry(maddenId)
+	history, err := handler.dataservice.GetEntryHistory(ctx.Request().Context(), maddenId)
 	if err != nil {
 		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
 			Code:    utilities.StatusCodeError(err),
 			Message: err.Error(),
 		})
 	}
-	return ctx.NoContent(http.StatusNoContent)
+	return ctx.JSON(http.StatusOK, history)
+}
+
+func (handler *maddenHandler) GetEntryMaddenIdHistoryDiff(ctx echo.Context, maddenId int, params swagger.GetEntryMaddenIdHistoryDiffParams) error {
+	if err := revisionDiffValid(maddenId, params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	diff, err := handler.dataservice.GetEntryRevisionDiff(ctx.Request().Context(), maddenId, params.From, params.To)
+	if err != nil {
+		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
+			Code:    utilities.StatusCodeError(err),
+			Message: err.Error(),
+		})
+	}
+	return ctx.JSON(http.StatusOK, diff)
+}
+
+func (handler *maddenHandler) PostEntryMaddenIdRestore(ctx echo.Context, maddenId int) error {
+	if err := deleteEntryValid(maddenId); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	restored, err := handler.dataservice.RestoreEntry(ctx.Request().Context(), maddenId, handler.strictConflicts, actorFromRequest(ctx))
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	setEntityTag(ctx, restored)
+	return ctx.JSON(http.StatusOK, restored)
+}
+
+func (handler *maddenHandler) GetTrash(ctx echo.Context, params swagger.GetTrashParams) error {
+	if params.PageNumber == nil {
+		params.PageNumber = utilities.IntPtr(PAGE_NUMBER_DEFAULT)
+	}
+	if params.PageSize == nil {
+		params.PageSize = utilities.IntPtr(PAGE_SIZE_DEFAULT)
+	}
+	if *params.PageNumber < 0 || *params.PageSize < 0 {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid parameters",
+		})
+	}
+	trash, err := handler.dataservice.GetTrash(ctx.Request().Context(), *params.PageNumber, *params.PageSize)
+	if err != nil {
+		return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
+			Code:    utilities.StatusCodeError(err),
+			Message: err.Error(),
+		})
+	}
+	return ctx.JSON(http.StatusOK, trash)
 }
 
 //implementation helpers
 
+//writeErrorResponse writes err as an error response, conflicts carry the id of the existing entry or image
+func writeErrorResponse(ctx echo.Context, err error) error {
+	switch converted := err.(type) {
+	case models.ConflictError:
+		return ctx.JSON(converted.Code, swagger.ConflictError{
+			Code:       converted.Code,
+			ExistingId: int(converted.ExistingId),
+			Message:    converted.Message,
+		})
+	case dataservice.OverlapError:
+		return ctx.JSON(converted.Code, swagger.ConflictError{
+			Code:       converted.Code,
+			Conflicts:  &converted.Conflicts,
+			ExistingId: int(converted.ExistingId),
+			Message:    converted.Message,
+		})
+	case models.ImageInUseError:
+		entryIds := []int{}
+		for _, id := range converted.EntryIds {
+			entryIds = append(entryIds, int(id))
+		}
+		return ctx.JSON(converted.Code, swagger.ImageInUse{
+			Code:     converted.Code,
+			EntryIds: entryIds,
+			Message:  converted.Message,
+		})
+	}
+	return ctx.JSON(utilities.StatusCodeError(err), swagger.ErrorResponse{
+		Code:    utilities.StatusCodeError(err),
+		Message: err.Error(),
+	})
+}
+
+//actorFromRequest returns the user responsible for a request, or DEFAULT_ACTOR if none was identified
+func actorFromRequest(ctx echo.Context) string {
+	if actor := ctx.Request().Header.Get(ACTOR_HEADER); actor != "" {
+		return actor
+	}
+	return DEFAULT_ACTOR
+}
+
+//setEntityTag sets the ETag header of a single entry response to the entry version
+func setEntityTag(ctx echo.Context, item swagger.MaddenItem) {
+	setVersionTag(ctx, item.Version)
+}
+
+//setVersionTag sets the ETag header of a single entry or image response to version
+func setVersionTag(ctx echo.Context, version *int) {
+	if version != nil {
+		ctx.Response().Header().Set(ETAG_HEADER, entityTag(*version))
+	}
+}
+
+//expectedVersionFromRequest returns the version named by the If-Match header of an update
+//a missing header is a 428 error and a tag that names no version is a 412 error
+func expectedVersionFromRequest(ctx echo.Context) (int, error) {
+	ifMatch := ctx.Request().Header.Get(IF_MATCH_HEADER)
+	if ifMatch == "" {
+		return 0, models.NewDataServiceError("updates must send the ETag they replace in the If-Match header", http.StatusPreconditionRequired)
+	}
+	version, ok := versionFromEntityTag(ifMatch)
+	if !ok {
+		return 0, models.NewDataServiceError(fmt.Sprintf("If-Match %s does not match any version", ifMatch), http.StatusPreconditionFailed)
+	}
+	return version, nil
+}
+
+//entityTag formats version as a strong entity tag
+func entityTag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+//versionFromEntityTag parses an entity tag built by entityTag, weak tags are accepted as the version is exact either way
+func versionFromEntityTag(tag string) (int, bool) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		return 0, false
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
+
+//revisionDiffValid ensures an item id and both revisions are valid
+func revisionDiffValid(id int, params swagger.GetEntryMaddenIdHistoryDiffParams) error {
+	if err := deleteEntryValid(id); err != nil {
+		return err
+	}
+	if params.From < 1 || params.To < 1 {
+		return fmt.Errorf("revisions must be positive integers")
+	}
+	return nil
+}
+
 //getSingleItem retrieves a single item and returns it as the single item in a slice
-func (handler *maddenHandler) getSingleItem(params swagger.GetEntryParams) ([]swagger.MaddenItem, error) {
-	item, err := handler.dataservice.GetMaddenById(*params.Id)
+//getSingleItem writes the entry with the id of params as a page of one, tagged with the entry version so the tag can be sent back as If-Match
+func (handler *maddenHandler) getSingleItem(ctx echo.Context, params swagger.GetEntryParams) error {
+	item, err := handler.dataservice.GetMaddenById(ctx.Request().Context(), *params.Id)
 	if err != nil {
-		return nil, err
+		return ctx.JSON(utilities.StatusCodeError(err), swagger.Error{
+			Code:    utilities.StatusCodeError(err),
+			Message: err.Error(),
+		})
+	}
+	tag := ""
+	if item.Version != nil {
+		tag = entityTag(*item.Version)
 	}
-	return []swagger.MaddenItem{item}, nil
+	return writeConditionalJSON(ctx, swagger.MaddenItems{Entries: []swagger.MaddenItem{item}}, tag, newestUpdate(item.UpdatedAt))
 }
 
 //deleteEntryValid ensures that a given id is valid
@@ -230,9 +442,15 @@ func createEntryValid(item swagger.MaddenItem) error {
 	if _, err := time.Parse(time.RFC3339, item.EndDate); err != nil {
 		return fmt.Errorf("time format of end date was not valid, expect RFC3339")
 	}
-	if _, err := time.Parse(time.RFC3339, item.StartDate); err != nil {
+	start, err := time.Parse(time.RFC3339, item.StartDate)
+	if err != nil {
 		return fmt.Errorf("time format of start date was not valid, expect RFC3339")
 	}
+	if item.Rrule != nil && *item.Rrule != "" {
+		if err := rruleValid(*item.Rrule, start); err != nil {
+			return err
+		}
+	}
 	for _, image := range item.Images {
 		if err := validateImage(image); err != nil {
 			return err
@@ -241,6 +459,18 @@ func createEntryValid(item swagger.MaddenItem) error {
 	return nil
 }
 
+//rruleValid returns an error if rule is not a supported recurrence rule or ends before the entry starting at start
+func rruleValid(rule string, start time.Time) error {
+	recurrence, err := maddendb.ParseRRule(rule)
+	if err != nil {
+		return err
+	}
+	if recurrence.Until != 0 && recurrence.Until < start.Unix() {
+		return fmt.Errorf("rrule UNTIL must not be before the start date")
+	}
+	return nil
+}
+
 //validateImage returns an error with detailed message if the passed MaddenImage is invalid
 func validateImage(image swagger.MaddenImage) error {
 	if image.Id < 0 {
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/labstack/echo/v4"
+)
+
+//recurring entry occurrence handlers
+
+func (handler *maddenHandler) PutEntryMaddenIdOccurrencesOccurrenceStart(ctx echo.Context, maddenId int, occurrenceStart string) error {
+	occurrenceBody := swagger.EntryOccurrence{}
+	if err := ctx.Bind(&occurrenceBody); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if occurrenceBody.OccurrenceStart == "" {
+		occurrenceBody.OccurrenceStart = occurrenceStart
+	}
+	if err := occurrenceValid(occurrenceBody, maddenId, occurrenceStart); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	return handler.setOccurrence(ctx, maddenId, occurrenceBody)
+}
+
+func (handler *maddenHandler) DeleteEntryMaddenIdOccurrencesOccurrenceStart(ctx echo.Context, maddenId int, occurrenceStart string) error {
+	if err := occurrenceStartValid(maddenId, occurrenceStart); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	return handler.setOccurrence(ctx, maddenId, swagger.EntryOccurrence{OccurrenceStart: occurrenceStart, Cancelled: true})
+}
+
+//setOccurrence stores occurrence against the recurring entry with id, responding with the series
+func (handler *maddenHandler) setOccurrence(ctx echo.Context, id int, occurrence swagger.EntryOccurrence) error {
+	expectedVersion, err := expectedVersionFromRequest(ctx)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	updated, err := handler.dataservice.SetEntryOccurrence(ctx.Request().Context(), id, occurrence, expectedVersion, handler.strictConflicts, actorFromRequest(ctx))
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	setEntityTag(ctx, updated)
+	return ctx.JSON(http.StatusOK, updated)
+}
+
+//occurrenceStartValid ensures an item id and the start identifying one of its occurrences are valid
+func occurrenceStartValid(id int, occurrenceStart string) error {
+	if err := deleteEntryValid(id); err != nil {
+		return err
+	}
+	if !validDate(occurrenceStart) {
+		return fmt.Errorf("time format of occurrence start was not valid, expect RFC3339")
+	}
+	return nil
+}
+
+//occurrenceValid returns an error with detailed message if the passed edit of the occurrence at occurrenceStart is invalid
+//cancelled occurrences need no other fields
+func occurrenceValid(occurrence swagger.EntryOccurrence, id int, occurrenceStart string) error {
+	if err := occurrenceStartValid(id, occurrenceStart); err != nil {
+		return err
+	}
+	if !validDate(occurrence.OccurrenceStart) || !sameInstant(occurrence.OccurrenceStart, occurrenceStart) {
+		return fmt.Errorf("path occurrence start must match occurrence start in body")
+	}
+	if occurrence.Cancelled {
+		return nil
+	}
+	if occurrence.Summary == nil || len(*occurrence.Summary) < MINIMUM_SUMMARY_LENGTH {
+		return fmt.Errorf("occurrence summary must be at least %d in length", MINIMUM_SUMMARY_LENGTH)
+	}
+	if occurrence.StartDate == nil || !validDate(*occurrence.StartDate) {
+		return fmt.Errorf("time format of start date was not valid, expect RFC3339")
+	}
+	if occurrence.EndDate == nil || !validDate(*occurrence.EndDate) {
+		return fmt.Errorf("time format of end date was not valid, expect RFC3339")
+	}
+	return nil
+}
+
+//sameInstant returns true if two valid RFC3339 times name the same instant, whatever their offsets
+func sameInstant(first, second string) bool {
+	firstTime, _ := time.Parse(time.RFC3339, first)
+	secondTime, _ := time.Parse(time.RFC3339, second)
+	return firstTime.Equal(secondTime)
+}
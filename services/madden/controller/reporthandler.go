@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/dataservice"
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/labstack/echo/v4"
+)
+
+//reporting handlers
+
+const (
+	AVAILABILITY_FILE_NAME = "madden-availability"
+	//recurring entries are expanded across the whole range, so it is kept to the span of a conflict listing
+	MAXIMUM_REPORT_RANGE = MAXIMUM_CONFLICT_RANGE
+)
+
+func (handler *maddenHandler) GetReportsAvailability(ctx echo.Context, params swagger.GetReportsAvailabilityParams) error {
+	params = fillReportDefaults(params)
+	if err := reportParamsValid(params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	report, err := handler.dataservice.GetAvailability(ctx.Request().Context(), params)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	if *params.Format == dataservice.REPORT_JSON {
+		return ctx.JSON(http.StatusOK, report)
+	}
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s.%s", AVAILABILITY_FILE_NAME, dataservice.REPORT_CSV))
+	response.WriteHeader(http.StatusOK)
+	if err := dataservice.WriteAvailabilityCsv(report, response); err != nil {
+		fmt.Printf("availability report ended early ERROR: %s\n", err.Error())
+	}
+	return nil
+}
+
+//fillReportDefaults fills a missing granularity with month and a missing format with json
+func fillReportDefaults(params swagger.GetReportsAvailabilityParams) swagger.GetReportsAvailabilityParams {
+	if params.Granularity == nil {
+		granularity := swagger.GetReportsAvailabilityParamsGranularity(dataservice.GRANULARITY_MONTH)
+		params.Granularity = &granularity
+	}
+	if params.Format == nil {
+		format := swagger.GetReportsAvailabilityParamsFormat(dataservice.REPORT_JSON)
+		params.Format = &format
+	}
+	return params
+}
+
+//reportParamsValid ensures the range of a report is valid and no longer than MAXIMUM_REPORT_RANGE, and its granularity and format are known
+func reportParamsValid(params swagger.GetReportsAvailabilityParams) error {
+	from, err := time.Parse(time.RFC3339, params.From)
+	if err != nil {
+		return fmt.Errorf("time format of from was not valid, expect RFC3339")
+	}
+	to, err := time.Parse(time.RFC3339, params.To)
+	if err != nil {
+		return fmt.Errorf("time format of to was not valid, expect RFC3339")
+	}
+	if !to.After(from) {
+		return fmt.Errorf("to must be after from")
+	}
+	if to.Sub(from) > MAXIMUM_REPORT_RANGE {
+		return fmt.Errorf("the range between from and to must be at most %d days", int(MAXIMUM_REPORT_RANGE/(24*time.Hour)))
+	}
+	switch *params.Granularity {
+	case dataservice.GRANULARITY_DAY, dataservice.GRANULARITY_WEEK, dataservice.GRANULARITY_MONTH:
+	default:
+		return fmt.Errorf("granularity must be one of %s %s %s", dataservice.GRANULARITY_DAY, dataservice.GRANULARITY_WEEK, dataservice.GRANULARITY_MONTH)
+	}
+	if *params.Format != dataservice.REPORT_JSON && *params.Format != dataservice.REPORT_CSV {
+		return fmt.Errorf("format must be one of %s %s", dataservice.REPORT_JSON, dataservice.REPORT_CSV)
+	}
+	return nil
+}
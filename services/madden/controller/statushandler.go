@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+	"github.com/labstack/echo/v4"
+)
+
+//image status handlers
+
+func (handler *maddenHandler) GetStatus(ctx echo.Context, params swagger.GetStatusParams) error {
+	if params.At == nil {
+		params.At = utilities.StrPtr(time.Now().UTC().Truncate(time.Second).Format(time.RFC3339))
+	}
+	if !validDate(*params.At) {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "time format of at was not valid, expect RFC3339",
+		})
+	}
+	status, err := handler.dataservice.GetStatus(ctx.Request().Context(), params)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, status)
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/dataservice"
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/labstack/echo/v4"
+)
+
+//bulk export and import handlers
+
+const (
+	EXPORT_FILE_NAME = "madden-export"
+)
+
+var (
+	//content types of the export and import formats
+	transferContentTypes = map[string]string{
+		dataservice.TRANSFER_NDJSON: "application/x-ndjson",
+		dataservice.TRANSFER_CSV:    "text/csv; charset=utf-8",
+	}
+)
+
+func (handler *maddenHandler) GetExport(ctx echo.Context, params swagger.GetExportParams) error {
+	format := dataservice.TRANSFER_NDJSON
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
+	contentType, known := transferContentTypes[format]
+	if !known {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: fmt.Sprintf("format must be one of %s %s", dataservice.TRANSFER_NDJSON, dataservice.TRANSFER_CSV),
+		})
+	}
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, contentType)
+	response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s.%s", EXPORT_FILE_NAME, format))
+	//the status is sent with the first record, an error after that ends the stream early with an error record
+	if err := handler.dataservice.Export(ctx.Request().Context(), format, response); err != nil {
+		if response.Committed {
+			fmt.Printf("export ended early ERROR: %s\n", err.Error())
+			return nil
+		}
+		return writeErrorResponse(ctx, err)
+	}
+	if !response.Committed {
+		response.WriteHeader(http.StatusOK)
+	}
+	return nil
+}
+
+func (handler *maddenHandler) PostImport(ctx echo.Context, params swagger.PostImportParams) error {
+	format := dataservice.TRANSFER_NDJSON
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
+	mode := dataservice.IMPORT_SKIP
+	if params.Mode != nil {
+		mode = string(*params.Mode)
+	}
+	if mode != dataservice.IMPORT_SKIP && mode != dataservice.IMPORT_UPSERT {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: fmt.Sprintf("mode must be one of %s %s", dataservice.IMPORT_SKIP, dataservice.IMPORT_UPSERT),
+		})
+	}
+	dryRun := params.DryRun != nil && *params.DryRun
+	request := ctx.Request()
+	if request.ContentLength > handler.maxImportBytes {
+		return importTooLarge(ctx, handler.maxImportBytes)
+	}
+	request.Body = http.MaxBytesReader(ctx.Response(), request.Body, handler.maxImportBytes)
+	lines, lineErrors, err := dataservice.DecodeTransfer(format, request.Body)
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			return importTooLarge(ctx, handler.maxImportBytes)
+		}
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	valid := []dataservice.TransferLine{}
+	for _, line := range lines {
+		if err := transferRecordValid(line.Record); err != nil {
+			lineErrors = append(lineErrors, swagger.ImportLineError{Line: line.Line, Message: err.Error()})
+			continue
+		}
+		valid = append(valid, line)
+	}
+	//the valid records are still checked against the data store so every failure is reported, but none are written
+	result, err := handler.dataservice.Import(request.Context(), valid, mode, dryRun || len(lineErrors) > 0, actorFromRequest(ctx))
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	result.Errors = append(result.Errors, lineErrors...)
+	dataservice.SortLineErrors(result.Errors)
+	if len(result.Errors) > 0 {
+		return ctx.JSON(http.StatusUnprocessableEntity, result)
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func importTooLarge(ctx echo.Context, maxBytes int64) error {
+	return ctx.JSON(http.StatusRequestEntityTooLarge, swagger.ErrorResponse{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: fmt.Sprintf("imports must be no more than %d bytes", maxBytes),
+	})
+}
+
+//transferRecordValid applies the rules of the matching create endpoint to an imported record
+func transferRecordValid(record swagger.TransferRecord) error {
+	switch {
+	case record.Entry != nil:
+		return createEntryValid(*record.Entry)
+	case record.Image != nil:
+		if record.Image.Id < 0 {
+			return fmt.Errorf("image id was invalid must be positive integer")
+		}
+		return imageFileValid(*record.Image)
+	case record.Summary != nil:
+		return validateSummary(*record.Summary)
+	}
+	return nil
+}
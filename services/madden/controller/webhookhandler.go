@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+	"github.com/labstack/echo/v4"
+)
+
+//webhook subscription handlers
+
+const (
+	//shorter secrets are too easily guessed to sign deliveries
+	MINIMUM_WEBHOOK_SECRET_LENGTH = 16
+)
+
+func (handler *maddenHandler) GetWebhooks(ctx echo.Context) error {
+	webhooks, err := handler.dataservice.GetWebhooks(ctx.Request().Context())
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, webhooks)
+}
+
+func (handler *maddenHandler) PostWebhooks(ctx echo.Context) error {
+	webhookBody := swagger.Webhook{}
+	if err := ctx.Bind(&webhookBody); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	if err := webhookValid(webhookBody); err != nil {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		})
+	}
+	created, err := handler.dataservice.CreateWebhook(ctx.Request().Context(), webhookBody)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusCreated, created)
+}
+
+func (handler *maddenHandler) DeleteWebhooksWebhookId(ctx echo.Context, webhookId int) error {
+	if webhookId < 0 {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "webhook id was invalid must be positive integer",
+		})
+	}
+	if err := handler.dataservice.DeleteWebhook(ctx.Request().Context(), webhookId); err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (handler *maddenHandler) GetWebhooksWebhookId(ctx echo.Context, webhookId int) error {
+	if webhookId < 0 {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "webhook id was invalid must be positive integer",
+		})
+	}
+	webhook, err := handler.dataservice.GetWebhookById(ctx.Request().Context(), webhookId)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, webhook)
+}
+
+func (handler *maddenHandler) GetWebhooksWebhookIdDeliveries(ctx echo.Context, webhookId int, params swagger.GetWebhooksWebhookIdDeliveriesParams) error {
+	if params.PageNumber == nil {
+		params.PageNumber = utilities.IntPtr(PAGE_NUMBER_DEFAULT)
+	}
+	if params.PageSize == nil {
+		params.PageSize = utilities.IntPtr(PAGE_SIZE_DEFAULT)
+	}
+	if webhookId < 0 || *params.PageNumber < 0 || *params.PageSize < 0 {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "Invalid parameters",
+		})
+	}
+	status := ""
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	if status != "" && status != maddendb.DELIVERY_PENDING && status != maddendb.DELIVERY_DELIVERED && status != maddendb.DELIVERY_DEAD {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: fmt.Sprintf("status must be one of %s %s %s", maddendb.DELIVERY_PENDING, maddendb.DELIVERY_DELIVERED, maddendb.DELIVERY_DEAD),
+		})
+	}
+	deliveries, err := handler.dataservice.GetWebhookDeliveries(ctx.Request().Context(), webhookId, status, *params.PageNumber, *params.PageSize)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, deliveries)
+}
+
+func (handler *maddenHandler) PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx echo.Context, webhookId int, deliveryId int) error {
+	if webhookId < 0 || deliveryId < 0 {
+		return ctx.JSON(http.StatusBadRequest, swagger.ErrorResponse{
+			Code:    http.StatusBadRequest,
+			Message: "webhook and delivery ids must be positive integers",
+		})
+	}
+	delivery, err := handler.dataservice.RedeliverWebhookDelivery(ctx.Request().Context(), webhookId, deliveryId)
+	if err != nil {
+		return writeErrorResponse(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, delivery)
+}
+
+//webhookValid confirms a new webhook has an absolute http url, known distinct events and a long enough secret if it has one
+func webhookValid(webhook swagger.Webhook) error {
+	if webhook.Id != nil && *webhook.Id != 0 {
+		return fmt.Errorf("webhook ids are assigned by the server")
+	}
+	parsed, err := url.Parse(webhook.Url)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("webhook url must be an absolute http or https url")
+	}
+	seen := map[swagger.WebhookEvent]bool{}
+	for _, event := range webhook.Events {
+		if !maddendb.ValidWebhookEvent(string(event)) {
+			return fmt.Errorf("unknown webhook event %s", event)
+		}
+		if seen[event] {
+			return fmt.Errorf("webhook event %s is listed more than once", event)
+		}
+		seen[event] = true
+	}
+	if webhook.Secret != nil && *webhook.Secret != "" && len(*webhook.Secret) < MINIMUM_WEBHOOK_SECRET_LENGTH {
+		return fmt.Errorf("webhook secret must be at least %d characters", MINIMUM_WEBHOOK_SECRET_LENGTH)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package dataservice
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+)
+
+//response caching of the entry list, summary and published reads
+
+const (
+	CACHE_KEY_SUMMARY          = "summary"
+	CACHE_KEY_PUBLISHED        = "published"
+	CACHE_KEY_ENTRIES_MODIFIED = "entries-modified"
+)
+
+//ResponseCache holds the responses of reads until a write invalidates them
+//cached values are shared between requests and must not be modified
+type ResponseCache interface {
+	//Load returns the value cached under key, otherwise it calls load and caches the value it returns
+	//values loaded while the cache is invalidated are returned but not cached, as they may predate the write
+	Load(key string, load func() (interface{}, error)) (interface{}, error)
+	//Invalidate removes every cached value
+	Invalidate()
+	//Stats returns the counters of the cache since it was built
+	Stats() CacheStats
+}
+
+//CacheStats counts the lookups of a cache and what became of its values
+type CacheStats struct {
+	Hits          uint64
+	Misses        uint64
+	Evictions     uint64
+	Expirations   uint64
+	Invalidations uint64
+	Entries       int
+	Capacity      int
+	Ttl           time.Duration
+}
+
+//lruCache keeps up to capacity values for at most ttl each, evicting the least recently used value when full
+type lruCache struct {
+	lock     sync.Mutex
+	capacity int
+	ttl      time.Duration
+	//elements of order by key, the front of order is the most recently used
+	elements map[string]*list.Element
+	order    *list.List
+	//raised by every invalidation, a value is only cached if no invalidation happened while it loaded
+	generation uint64
+	stats      CacheStats
+}
+
+//cacheEntry is a cached value and the time it expires
+type cacheEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+//NewLRUCache returns an in process ResponseCache holding up to capacity values, each for at most ttl
+func NewLRUCache(capacity int, ttl time.Duration) ResponseCache {
+	return &lruCache{
+		capacity: capacity,
+		ttl:      ttl,
+		elements: map[string]*list.Element{},
+		order:    list.New(),
+		stats:    CacheStats{Capacity: capacity, Ttl: ttl},
+	}
+}
+
+func (cache *lruCache) Load(key string, load func() (interface{}, error)) (interface{}, error) {
+	cache.lock.Lock()
+	if element, exists := cache.elements[key]; exists {
+		entry := element.Value.(*cacheEntry)
+		if time.Now().Before(entry.expires) {
+			cache.order.MoveToFront(element)
+			cache.stats.Hits++
+			cache.lock.Unlock()
+			return entry.value, nil
+		}
+		cache.remove(element)
+		cache.stats.Expirations++
+	}
+	cache.stats.Misses++
+	generation := cache.generation
+	cache.lock.Unlock()
+	//loaded without the lock so a slow read never holds up others
+	value, err := load()
+	if err != nil {
+		return nil, err
+	}
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if generation == cache.generation {
+		cache.store(key, value)
+	}
+	return value, nil
+}
+
+func (cache *lruCache) Invalidate() {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.generation++
+	cache.stats.Invalidations++
+	cache.elements = map[string]*list.Element{}
+	cache.order.Init()
+}
+
+func (cache *lruCache) Stats() CacheStats {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	stats := cache.stats
+	stats.Entries = cache.order.Len()
+	return stats
+}
+
+//store caches value under key, evicting the least recently used values beyond capacity, callers must hold the lock
+func (cache *lruCache) store(key string, value interface{}) {
+	entry := &cacheEntry{key: key, value: value, expires: time.Now().Add(cache.ttl)}
+	if element, exists := cache.elements[key]; exists {
+		element.Value = entry
+		cache.order.MoveToFront(element)
+		return
+	}
+	cache.elements[key] = cache.order.PushFront(entry)
+	for cache.order.Len() > cache.capacity {
+		cache.remove(cache.order.Back())
+		cache.stats.Evictions++
+	}
+}
+
+//remove drops element from the cache, callers must hold the lock
+func (cache *lruCache) remove(element *list.Element) {
+	cache.order.Remove(element)
+	delete(cache.elements, element.Value.(*cacheEntry).key)
+}
+
+//cached returns the value cached under key, loading it with load on a miss, load is called every time if caching is disabled
+func (ds *pgDataService) cached(key string, load func() (interface{}, error)) (interface{}, error) {
+	if ds.cache == nil {
+		return load()
+	}
+	return ds.cache.Load(key, load)
+}
+
+//invalidateCache drops every cached response, it is deferred by every write so reads made after the write see it
+func (ds *pgDataService) invalidateCache() {
+	if ds.cache != nil {
+		ds.cache.Invalidate()
+	}
+}
+
+//invalidateOnEvents drops every cached response on each change event, so writes made by other instances or straight to the data store are seen
+//the cache is also dropped on every subscription as events may have been missed while unsubscribed, it never returns
+func (ds *pgDataService) invalidateOnEvents() {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), EVENT_READ_TIMEOUT)
+		err := ds.events.start(ctx)
+		cancel()
+		if err != nil {
+			fmt.Printf("cache can not follow change events, retrying in %s ERROR: %s\n", EVENT_RETRY_INTERVAL, err.Error())
+			time.Sleep(EVENT_RETRY_INTERVAL)
+			continue
+		}
+		live, _ := ds.events.subscribe()
+		ds.cache.Invalidate()
+		//closed if the broker drops the cache for falling behind
+		for range live {
+			ds.cache.Invalidate()
+		}
+	}
+}
+
+//entriesCacheKey normalizes filled entry params into a cache key, dates are compared by the instant they name
+func entriesCacheKey(params swagger.GetEntryParams) string {
+	return fmt.Sprintf("entries:%d:%d:%d:%d:%s:%s:%q:%q",
+		convertTime(*params.StartDate),
+		convertTime(*params.EndDate),
+		*params.PageNumber,
+		*params.PageSize,
+		*params.Sort,
+		*params.Historic,
+		nullSafeString(params.Cursor),
+		nullSafeString(params.Q),
+	)
+}
+
+func (ds *pgDataService) GetCacheStats(ctx context.Context) (swagger.CacheStats, error) {
+	if ds.cache == nil {
+		return swagger.CacheStats{Enabled: false}, nil
+	}
+	stats := ds.cache.Stats()
+	converted := swagger.CacheStats{
+		Capacity:      stats.Capacity,
+		Enabled:       true,
+		Entries:       stats.Entries,
+		Evictions:     int64(stats.Evictions),
+		Expirations:   int64(stats.Expirations),
+		Hits:          int64(stats.Hits),
+		Invalidations: int64(stats.Invalidations),
+		Misses:        int64(stats.Misses),
+		TtlSeconds:    int(stats.Ttl / time.Second),
+	}
+	if lookups := stats.Hits + stats.Misses; lookups > 0 {
+		converted.HitRatio = float64(stats.Hits) / float64(lookups)
+	}
+	return converted, nil
+}
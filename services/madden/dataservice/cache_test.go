@@ -0,0 +1,130 @@
+package dataservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+)
+
+//countingLoader returns a load function returning value and counting its calls in loads
+func countingLoader(value string, loads *int) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		*loads++
+		return value, nil
+	}
+}
+
+func TestCacheEvictionOrder(t *testing.T) {
+	cache := NewLRUCache(2, time.Hour)
+	loads := 0
+	cache.Load("a", countingLoader("a", &loads))
+	cache.Load("b", countingLoader("b", &loads))
+	//reading a makes b the least recently used
+	cache.Load("a", countingLoader("a", &loads))
+	cache.Load("c", countingLoader("c", &loads))
+	if loads != 3 {
+		t.Errorf("expected 3 loads filling the cache got %d\n", loads)
+	}
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{key: "a", expected: true},
+		{key: "c", expected: true},
+		{key: "b", expected: false},
+	}
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			before := loads
+			value, err := cache.Load(test.key, countingLoader(test.key, &loads))
+			if err != nil || value != test.key {
+				t.Errorf("expected %s got %v ERROR: %v\n", test.key, value, err)
+			}
+			if cached := loads == before; cached != test.expected {
+				t.Errorf("expected %s cached %t got %t\n", test.key, test.expected, cached)
+			}
+		})
+	}
+	stats := cache.Stats()
+	//c then b were evicted, reloading b evicted a
+	if stats.Evictions != 2 || stats.Entries != 2 {
+		t.Errorf("expected 2 evictions and 2 entries got %d evictions and %d entries\n", stats.Evictions, stats.Entries)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	cache := NewLRUCache(2, 10*time.Millisecond)
+	loads := 0
+	cache.Load("a", countingLoader("a", &loads))
+	cache.Load("a", countingLoader("a", &loads))
+	if loads != 1 {
+		t.Errorf("expected a cached before it expires got %d loads\n", loads)
+	}
+	time.Sleep(20 * time.Millisecond)
+	cache.Load("a", countingLoader("a", &loads))
+	if loads != 2 {
+		t.Errorf("expected an expired value to be loaded again got %d loads\n", loads)
+	}
+	stats := cache.Stats()
+	if stats.Expirations != 1 || stats.Hits != 1 || stats.Misses != 2 {
+		t.Errorf("expected 1 expiration, 1 hit and 2 misses got %+v\n", stats)
+	}
+}
+
+func TestCacheInvalidatedWhileLoading(t *testing.T) {
+	cache := NewLRUCache(2, time.Hour)
+	loads := 0
+	//a write landing while the value loads may not be in it, so the value is returned but not cached
+	value, err := cache.Load("a", func() (interface{}, error) {
+		loads++
+		cache.Invalidate()
+		return "stale", nil
+	})
+	if err != nil || value != "stale" {
+		t.Errorf("expected the loaded value returned got %v ERROR: %v\n", value, err)
+	}
+	value, _ = cache.Load("a", countingLoader("fresh", &loads))
+	if loads != 2 || value != "fresh" {
+		t.Errorf("expected a value loaded during an invalidation not to be cached got %v after %d loads\n", value, loads)
+	}
+	cache.Load("a", countingLoader("fresh", &loads))
+	if loads != 2 {
+		t.Errorf("expected a value loaded after the invalidation to be cached got %d loads\n", loads)
+	}
+}
+
+func TestCacheInvalidatedByEvents(t *testing.T) {
+	ctx := context.Background()
+	db := maddendb.NewMemoryMadden()
+	if err := db.SetupDatabase(ctx); err != nil {
+		t.Fatalf("unable to setup database ERROR: %s\n", err.Error())
+	}
+	cache := NewLRUCache(2, time.Hour)
+	NewPgDataService(db, utilities.NewSimpleAppender("https://madden.example/images/"), nil, 0, cache)
+	deadline := time.Now().Add(5 * time.Second)
+	//the cache is dropped once it starts following events
+	for cache.Stats().Invalidations == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the cache to follow change events\n")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	loads := 0
+	cache.Load(CACHE_KEY_SUMMARY, countingLoader("before", &loads))
+	//written straight to the data store as another instance would
+	if _, err := db.CreateSummary(ctx, maddendb.Summary{Summary: "all systems"}); err != nil {
+		t.Fatalf("unable to create summary ERROR: %s\n", err.Error())
+	}
+	for {
+		if value, _ := cache.Load(CACHE_KEY_SUMMARY, countingLoader("after", &loads)); value == "after" {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the change event to invalidate the cache\n")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
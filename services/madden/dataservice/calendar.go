@@ -0,0 +1,150 @@
+package dataservice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+)
+
+//renders madden items as an iCalendar (RFC 5545) feed
+
+const (
+	CALENDAR_PRODUCT_ID = "-//PurplWarrior22//Madden Maintenance//EN"
+	CALENDAR_NAME       = "Madden Maintenance"
+	//right hand side of every event UID, entry ids are only unique within a single madden service
+	CALENDAR_UID_DOMAIN = "madden"
+	//date times are always written in UTC
+	CALENDAR_TIME_FORMAT = "20060102T150405Z"
+	//lines longer than this many octets, not counting the line break, are folded
+	CALENDAR_LINE_LIMIT = 75
+	CALENDAR_LINE_BREAK = "\r\n"
+)
+
+var (
+	//characters escaped in TEXT values, backslash is replaced first so the escapes it adds are left alone
+	calendarTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+)
+
+func (ds *pgDataService) GetCalendar(ctx context.Context, params swagger.GetEntryParams) ([]byte, error) {
+	sortField := convertToSortField(*params.Sort)
+	calendar := calendarWriter{}
+	calendar.line("BEGIN", "VCALENDAR")
+	calendar.line("VERSION", "2.0")
+	calendar.line("PRODID", CALENDAR_PRODUCT_ID)
+	calendar.line("CALSCALE", "GREGORIAN")
+	calendar.line("METHOD", "PUBLISH")
+	calendar.line("X-WR-CALNAME", escapeCalendarText(CALENDAR_NAME))
+	var cursor *maddendb.ItemCursor
+	for {
+		items, err := ds.db.GetMaddenItemsAfter(ctx, cursor, EXPORT_PAGE_SIZE, convertTime(*params.StartDate), convertTime(*params.EndDate), sortField, historicBool(*params.Historic))
+		if err != nil {
+			return nil, logAndReturnError(ctx, err)
+		}
+		for _, item := range items {
+			calendar.event(item)
+		}
+		if len(items) < EXPORT_PAGE_SIZE {
+			break
+		}
+		next := maddendb.NewItemCursor(items[len(items)-1], sortField)
+		cursor = &next
+	}
+	calendar.line("END", "VCALENDAR")
+	return calendar.buffer.Bytes(), nil
+}
+
+//calendarWriter builds the content lines of a calendar, folding any that are too long
+type calendarWriter struct {
+	buffer bytes.Buffer
+}
+
+//event writes item as a VEVENT, SEQUENCE starts at 0 and is raised by every update so clients replace their copy
+func (calendar *calendarWriter) event(item maddendb.MaddenItem) {
+	calendar.line("BEGIN", "VEVENT")
+	calendar.line("UID", calendarUid(item))
+	calendar.line("DTSTAMP", calendarTime(item.UpdatedAt))
+	calendar.line("CREATED", calendarTime(item.CreatedAt))
+	calendar.line("LAST-MODIFIED", calendarTime(item.UpdatedAt))
+	calendar.line("SEQUENCE", fmt.Sprint(calendarSequence(item.Version)))
+	calendar.line("DTSTART", calendarTime(time.Unix(item.BeginDate, 0)))
+	//an event may not end before it starts, without DTEND it takes no time
+	if item.EndDate > item.BeginDate {
+		calendar.line("DTEND", calendarTime(time.Unix(item.EndDate, 0)))
+	}
+	calendar.line("SUMMARY", escapeCalendarText(item.Summary))
+	if item.Details != "" {
+		calendar.line("DESCRIPTION", escapeCalendarText(item.Details))
+	}
+	if statuses := imageStatuses(item.ItemImages); len(statuses) > 0 {
+		categories := []string{}
+		for _, status := range statuses {
+			categories = append(categories, escapeCalendarText(status))
+		}
+		calendar.line("CATEGORIES", strings.Join(categories, ","))
+	}
+	calendar.line("END", "VEVENT")
+}
+
+//line writes a content line, folding it into lines of at most CALENDAR_LINE_LIMIT octets without splitting a character
+func (calendar *calendarWriter) line(name, value string) {
+	content := name + ":" + value
+	limit := CALENDAR_LINE_LIMIT
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		calendar.buffer.WriteString(content[:cut])
+		calendar.buffer.WriteString(CALENDAR_LINE_BREAK + " ")
+		content = content[cut:]
+		//the space starting a folded line counts towards its length
+		limit = CALENDAR_LINE_LIMIT - 1
+	}
+	calendar.buffer.WriteString(content)
+	calendar.buffer.WriteString(CALENDAR_LINE_BREAK)
+}
+
+//calendarUid returns the UID of the event for item, it never changes for the life of the entry
+//occurrences of a recurring entry are told apart by where the rule places them
+func calendarUid(item maddendb.MaddenItem) string {
+	if item.OccurrenceStart != 0 {
+		return fmt.Sprintf("entry-%d-%d@%s", item.ID, item.OccurrenceStart, CALENDAR_UID_DOMAIN)
+	}
+	return fmt.Sprintf("entry-%d@%s", item.ID, CALENDAR_UID_DOMAIN)
+}
+
+//calendarSequence returns the SEQUENCE of an entry at version, entries are created at version 1
+func calendarSequence(version uint) uint {
+	if version == 0 {
+		return 0
+	}
+	return version - 1
+}
+
+func calendarTime(moment time.Time) string {
+	return moment.UTC().Format(CALENDAR_TIME_FORMAT)
+}
+
+//imageStatuses returns the distinct statuses of itemImages in the order they are linked
+func imageStatuses(itemImages []maddendb.ItemImages) []string {
+	statuses := []string{}
+	seen := map[string]bool{}
+	for _, itemImage := range itemImages {
+		if itemImage.Status == "" || seen[itemImage.Status] {
+			continue
+		}
+		seen[itemImage.Status] = true
+		statuses = append(statuses, itemImage.Status)
+	}
+	return statuses
+}
+
+func escapeCalendarText(text string) string {
+	return calendarTextEscaper.Replace(text)
+}
@@ -0,0 +1,95 @@
+package dataservice
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+//unfoldCalendar joins folded content lines back together, failing the test if any line is too long or splits a character
+func unfoldCalendar(t *testing.T, written string) []string {
+	if !strings.HasSuffix(written, CALENDAR_LINE_BREAK) {
+		t.Fatalf("expected calendar to end with a line break got %q\n", written)
+	}
+	lines := []string{}
+	for _, physical := range strings.Split(strings.TrimSuffix(written, CALENDAR_LINE_BREAK), CALENDAR_LINE_BREAK) {
+		if len(physical) > CALENDAR_LINE_LIMIT {
+			t.Errorf("expected lines of at most %d octets got %d: %q\n", CALENDAR_LINE_LIMIT, len(physical), physical)
+		}
+		if !utf8.ValidString(physical) {
+			t.Errorf("expected folding to keep characters whole got %q\n", physical)
+		}
+		if strings.HasPrefix(physical, " ") && len(lines) > 0 {
+			lines[len(lines)-1] += physical[1:]
+			continue
+		}
+		lines = append(lines, physical)
+	}
+	return lines
+}
+
+func TestCalendarLine(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expectedLines []string
+	}{
+		{name: "short", value: "pump swap", expectedLines: []string{"SUMMARY:pump swap\r\n"}},
+		{name: "at the limit", value: strings.Repeat("a", 67), expectedLines: []string{"SUMMARY:" + strings.Repeat("a", 67) + "\r\n"}},
+		{name: "one over the limit", value: strings.Repeat("a", 68), expectedLines: []string{"SUMMARY:" + strings.Repeat("a", 67) + "\r\n", " a\r\n"}},
+		//the two octets of é are the 75th and 76th of the line, so it moves to the folded line
+		{name: "multibyte rune straddling the limit", value: strings.Repeat("a", 66) + "é" + "b", expectedLines: []string{"SUMMARY:" + strings.Repeat("a", 66) + "\r\n", " éb\r\n"}},
+		{name: "multibyte rune ending at the limit", value: strings.Repeat("a", 65) + "é" + "b", expectedLines: []string{"SUMMARY:" + strings.Repeat("a", 65) + "é\r\n", " b\r\n"}},
+		{name: "many multibyte runes", value: strings.Repeat("€", 60)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calendar := calendarWriter{}
+			calendar.line("SUMMARY", test.value)
+			written := calendar.buffer.String()
+			if test.expectedLines != nil && written != strings.Join(test.expectedLines, "") {
+				t.Errorf("expected %q got %q\n", strings.Join(test.expectedLines, ""), written)
+			}
+			unfolded := unfoldCalendar(t, written)
+			if len(unfolded) != 1 || unfolded[0] != "SUMMARY:"+test.value {
+				t.Errorf("expected unfolding to give back the content line got %q\n", unfolded)
+			}
+		})
+	}
+}
+
+func TestEscapeCalendarText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "plain", text: "pump swap", expected: "pump swap"},
+		{name: "semicolon", text: "pump; valve", expected: `pump\; valve`},
+		{name: "comma", text: "pump, valve", expected: `pump\, valve`},
+		{name: "backslash", text: `C:\pumps`, expected: `C:\\pumps`},
+		{name: "escaped backslash is not escaped twice", text: `\;`, expected: `\\\;`},
+		{name: "crlf", text: "pump\r\nvalve", expected: `pump\nvalve`},
+		{name: "lf", text: "pump\nvalve", expected: `pump\nvalve`},
+		{name: "cr", text: "pump\rvalve", expected: `pump\nvalve`},
+		{name: "everything", text: "a;b,c\\d\r\ne", expected: `a\;b\,c\\d\ne`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if escaped := escapeCalendarText(test.text); escaped != test.expected {
+				t.Errorf("expected %q got %q\n", test.expected, escaped)
+			}
+		})
+	}
+}
+
+func TestCalendarEscapedSummary(t *testing.T) {
+	summary := strings.Repeat("pump; valve, ", 8) + "C:\\pumps\r\nswap"
+	calendar := calendarWriter{}
+	calendar.line("SUMMARY", escapeCalendarText(summary))
+	unfolded := unfoldCalendar(t, calendar.buffer.String())
+	expected := "SUMMARY:" + strings.Repeat(`pump\; valve\, `, 8) + `C:\\pumps\nswap`
+	if len(unfolded) != 1 || unfolded[0] != expected {
+		t.Errorf("expected %q got %q\n", expected, unfolded)
+	}
+}
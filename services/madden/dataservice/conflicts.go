@@ -0,0 +1,96 @@
+package dataservice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+)
+
+//conflicts between entries booking the same image over overlapping times
+
+//OverlapError is returned when a strict write conflicts with other entries, ExistingId identifies the entry of the first conflict
+type OverlapError struct {
+	Message    string
+	Code       int
+	ExistingId uint
+	Conflicts  []swagger.EntryConflict
+}
+
+func (err OverlapError) Error() string {
+	return err.Message
+}
+
+//ErrorCode returns the error code associated with this overlap error
+func (err OverlapError) ErrorCode() int {
+	return err.Code
+}
+
+func (ds *pgDataService) GetConflicts(ctx context.Context, params swagger.GetConflictsParams) (swagger.Conflicts, error) {
+	conflicts, err := ds.db.GetMaddenItemConflicts(ctx, convertTime(*params.From), convertTime(*params.To))
+	if err != nil {
+		return swagger.Conflicts{}, logAndReturnError(ctx, err)
+	}
+	return swagger.Conflicts{
+		Conflicts: convertConflicts(conflicts),
+		From:      *params.From,
+		To:        *params.To,
+	}, nil
+}
+
+//overlapError converts the error of a strict write that conflicts with other entries
+func overlapError(err *maddendb.OverlapError) error {
+	return OverlapError{
+		Message:    err.Message,
+		Code:       http.StatusConflict,
+		ExistingId: err.Conflicts[0].Second.ID,
+		Conflicts:  convertConflicts(err.Conflicts),
+	}
+}
+
+//withConflicts converts the written item listing the conflicts the write found, the first window of each being the item
+func (ds *pgDataService) withConflicts(item maddendb.MaddenItem) swagger.MaddenItem {
+	converted := ds.convertSingleModel(item)
+	if len(item.Conflicts) == 0 {
+		return converted
+	}
+	conflicts := convertConflicts(item.Conflicts)
+	converted.Conflicts = &conflicts
+	return converted
+}
+
+func convertConflicts(conflicts []maddendb.ItemConflict) []swagger.EntryConflict {
+	converted := []swagger.EntryConflict{}
+	for _, conflict := range conflicts {
+		images := []swagger.ConflictImage{}
+		for _, image := range conflict.Images {
+			images = append(images, swagger.ConflictImage{
+				FirstStatus:  image.FirstStatus,
+				Id:           int(image.MaddenImageFileId),
+				SecondStatus: image.SecondStatus,
+			})
+		}
+		converted = append(converted, swagger.EntryConflict{
+			Contradictory: conflict.Contradictory(),
+			First:         convertConflictWindow(conflict.First),
+			Images:        images,
+			Second:        convertConflictWindow(conflict.Second),
+		})
+	}
+	return converted
+}
+
+func convertConflictWindow(window maddendb.MaddenItem) swagger.ConflictWindow {
+	converted := swagger.ConflictWindow{
+		EndDate:   formatTime(window.EndDate),
+		Id:        int(window.ID),
+		StartDate: formatTime(window.BeginDate),
+		Summary:   window.Summary,
+	}
+	if window.OccurrenceStart != 0 {
+		converted.OccurrenceStart = utilities.StrPtr(formatTime(window.OccurrenceStart))
+	}
+	return converted
+}
@@ -0,0 +1,209 @@
+package dataservice
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+)
+
+//live change event stream implementation of MaddenDataService
+
+const (
+	//change events read per query, by the broker and by replays
+	EVENT_PAGE_SIZE = 500
+	//live events buffered per subscriber, a subscriber that falls this far behind is dropped and must reconnect to resume
+	EVENT_SUBSCRIBER_BUFFER = 256
+	//wait before listening again, or reading again, after a failure
+	EVENT_RETRY_INTERVAL = 5 * time.Second
+	//deadline of each read of the change event log made by the broker
+	EVENT_READ_TIMEOUT = 10 * time.Second
+)
+
+//Event is a change streamed to live clients
+type Event struct {
+	//ids increase in commit order across every instance of the service
+	Id uint
+	//one of the maddendb EVENT_ constants
+	Event string
+	//json encoded payload of the event, the payload webhooks are sent
+	Data string
+}
+
+//eventBroker fans the change event log out to the subscribers of this instance, it is started by the first subscriber
+type eventBroker struct {
+	db   maddendb.Madden
+	lock sync.Mutex
+	//true once the broker is listening
+	started bool
+	//id of the last event broadcast
+	lastId      uint
+	subscribers map[chan Event]bool
+}
+
+func newEventBroker(db maddendb.Madden) *eventBroker {
+	return &eventBroker{db: db, subscribers: map[chan Event]bool{}}
+}
+
+func (ds *pgDataService) SubscribeEvents(ctx context.Context, lastEventId *uint) (<-chan Event, error) {
+	if err := ds.events.start(ctx); err != nil {
+		return nil, logAndReturnError(ctx, err)
+	}
+	live, from := ds.events.subscribe()
+	stream := make(chan Event)
+	go func() {
+		defer close(stream)
+		defer ds.events.unsubscribe(live)
+		sent := from
+		if lastEventId != nil {
+			sent = *lastEventId
+		}
+		//events up to the first live event are replayed from the log
+		for sent < from {
+			events, err := ds.db.GetChangeEvents(ctx, sent, EVENT_PAGE_SIZE)
+			if err != nil {
+				logAndReturnError(ctx, err)
+				return
+			}
+			for _, event := range events {
+				if !sendEvent(ctx, stream, convertChangeEvent(event)) {
+					return
+				}
+				sent = event.ID
+			}
+			if len(events) < EVENT_PAGE_SIZE {
+				break
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-live:
+				if !ok {
+					return
+				}
+				//already replayed, or already seen by a client resuming from another instance that is further ahead
+				if event.Id <= sent {
+					continue
+				}
+				if !sendEvent(ctx, stream, event) {
+					return
+				}
+				sent = event.Id
+			}
+		}
+	}()
+	return stream, nil
+}
+
+//start begins listening for change events unless the broker is already listening, events committed before it starts are not broadcast
+func (broker *eventBroker) start(ctx context.Context) error {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	if broker.started {
+		return nil
+	}
+	latest, err := broker.db.GetLatestChangeEventId(ctx)
+	if err != nil {
+		return err
+	}
+	broker.lastId = latest
+	broker.started = true
+	go broker.run()
+	return nil
+}
+
+//subscribe returns a channel receiving every event broadcast from now on and the id of the last event broadcast before it
+func (broker *eventBroker) subscribe() (chan Event, uint) {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	subscriber := make(chan Event, EVENT_SUBSCRIBER_BUFFER)
+	broker.subscribers[subscriber] = true
+	return subscriber, broker.lastId
+}
+
+//unsubscribe stops broadcasting to subscriber and closes it, unless it was already dropped
+func (broker *eventBroker) unsubscribe(subscriber chan Event) {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	if broker.subscribers[subscriber] {
+		delete(broker.subscribers, subscriber)
+		close(subscriber)
+	}
+}
+
+//run broadcasts newly committed events each time the data store announces some, it never returns
+func (broker *eventBroker) run() {
+	notify := make(chan struct{}, 1)
+	go broker.listen(notify)
+	for range notify {
+		broker.publish(notify)
+	}
+}
+
+//listen relays data store notifications to notify, listening again after any failure
+func (broker *eventBroker) listen(notify chan<- struct{}) {
+	for {
+		err := broker.db.ListenChangeEvents(context.Background(), notify)
+		fmt.Printf("listening for change events failed, retrying in %s ERROR: %s\n", EVENT_RETRY_INTERVAL, err.Error())
+		time.Sleep(EVENT_RETRY_INTERVAL)
+	}
+}
+
+//publish broadcasts every event after the last one broadcast, a failed read is retried through notify
+func (broker *eventBroker) publish(notify chan<- struct{}) {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), EVENT_READ_TIMEOUT)
+		events, err := broker.db.GetChangeEvents(ctx, broker.lastId, EVENT_PAGE_SIZE)
+		cancel()
+		if err != nil {
+			fmt.Printf("reading change events failed, retrying in %s ERROR: %s\n", EVENT_RETRY_INTERVAL, err.Error())
+			time.AfterFunc(EVENT_RETRY_INTERVAL, func() {
+				select {
+				case notify <- struct{}{}:
+				default:
+				}
+			})
+			return
+		}
+		broker.broadcast(events)
+		if len(events) < EVENT_PAGE_SIZE {
+			return
+		}
+	}
+}
+
+//broadcast sends events to every subscriber, dropping any subscriber whose buffer is full
+func (broker *eventBroker) broadcast(events []maddendb.ChangeEvent) {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	for _, changeEvent := range events {
+		event := convertChangeEvent(changeEvent)
+		for subscriber := range broker.subscribers {
+			select {
+			case subscriber <- event:
+			default:
+				delete(broker.subscribers, subscriber)
+				close(subscriber)
+			}
+		}
+		broker.lastId = changeEvent.ID
+	}
+}
+
+//sendEvent sends event on stream, returning false if ctx is done first
+func sendEvent(ctx context.Context, stream chan<- Event, event Event) bool {
+	select {
+	case stream <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func convertChangeEvent(event maddendb.ChangeEvent) Event {
+	return Event{Id: event.ID, Event: event.Event, Data: event.Payload}
+}
@@ -0,0 +1,283 @@
+package dataservice
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"mime"
+	"path"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+)
+
+//renders recent and upcoming madden items as RSS 2.0 and Atom feeds
+
+const (
+	FEED_RSS   = "rss"
+	FEED_ATOM  = "atom"
+	FEED_TITLE = "Madden Maintenance"
+	//entries that ended longer ago than this are left out of feeds
+	FEED_RECENT_PERIOD = 7 * 24 * time.Hour
+	//the most entries listed by a feed, the soonest starting are kept
+	FEED_ITEM_LIMIT = 100
+	//feeds are identified by URN so ids survive the service moving
+	FEED_ID_PREFIX  = "urn:madden:"
+	ATOM_NAMESPACE  = "http://www.w3.org/2005/Atom"
+	FEED_MIME_TYPE  = "application/octet-stream"
+	ENCLOSURE_LINK  = "enclosure"
+	SELF_LINK       = "self"
+	RSS_CONTENT     = "application/rss+xml"
+	ATOM_CONTENT    = "application/atom+xml"
+	RSS_VERSION     = "2.0"
+	ATOM_TEXT_TYPE  = "text"
+	FEED_NO_SUMMARY = "Scheduled maintenance"
+)
+
+//feedContent is everything a feed lists, nothing but the feed times is filled while madden is unpublished
+type feedContent struct {
+	summary string
+	updated time.Time
+	items   []maddendb.MaddenItem
+}
+
+func (ds *pgDataService) GetFeed(ctx context.Context, format string, selfLink string) ([]byte, error) {
+	content, err := ds.getFeedContent(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	//nothing has been written yet, the epoch is the oldest time every reader accepts
+	if content.updated.IsZero() {
+		content.updated = time.Unix(0, 0)
+	}
+	var feed interface{}
+	switch format {
+	case FEED_RSS:
+		feed = ds.buildRss(content, selfLink)
+	case FEED_ATOM:
+		feed = ds.buildAtom(content, selfLink)
+	default:
+		return nil, fmt.Errorf("unknown feed format %s", format)
+	}
+	encoded, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, logAndReturnError(ctx, err)
+	}
+	return append([]byte(xml.Header), encoded...), nil
+}
+
+//getFeedContent reads the summary and the non historic items ending after FEED_RECENT_PERIOD before now, unless madden is unpublished
+func (ds *pgDataService) getFeedContent(ctx context.Context, now time.Time) (feedContent, error) {
+	content := feedContent{summary: FEED_NO_SUMMARY}
+	published, err := ds.db.GetPublished(ctx)
+	if err != nil {
+		return content, logAndReturnError(ctx, err)
+	}
+	content.updated = published.UpdatedAt
+	if !published.Published {
+		return content, nil
+	}
+	summary, err := ds.db.GetSummary(ctx)
+	if err != nil {
+		return content, logAndReturnError(ctx, err)
+	}
+	if summary.Summary != "" {
+		content.summary = summary.Summary
+	}
+	content.updated = latestTime(content.updated, summary.UpdatedAt)
+	content.items, err = ds.db.GetMaddenItemsAfter(ctx, nil, FEED_ITEM_LIMIT, math.MaxInt64, now.Add(-FEED_RECENT_PERIOD).Unix(), maddendb.StartDate, false)
+	if err != nil {
+		return content, logAndReturnError(ctx, err)
+	}
+	for _, item := range content.items {
+		content.updated = latestTime(content.updated, item.UpdatedAt)
+	}
+	return content, nil
+}
+
+//rss elements
+
+type rssFeed struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	Namespace string     `xml:"xmlns:atom,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	SelfLink      atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Description string        `xml:"description"`
+	Guid        rssGuid       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Categories  []string      `xml:"category"`
+	Enclosure   *rssEnclosure `xml:"enclosure"`
+}
+
+type rssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	Url    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+//buildRss lists content as an rss channel, rss allows a single enclosure so only the first image of an item is enclosed
+func (ds *pgDataService) buildRss(content feedContent, selfLink string) rssFeed {
+	channel := rssChannel{
+		Title:         FEED_TITLE,
+		Link:          selfLink,
+		Description:   content.summary,
+		SelfLink:      atomLink{Rel: SELF_LINK, Href: selfLink, Type: RSS_CONTENT},
+		LastBuildDate: content.updated.UTC().Format(time.RFC1123Z),
+		Items:         []rssItem{},
+	}
+	for _, item := range content.items {
+		converted := rssItem{
+			Title:       item.Summary,
+			Description: feedItemDescription(item),
+			Guid:        rssGuid{Value: feedItemId(item)},
+			PubDate:     item.CreatedAt.UTC().Format(time.RFC1123Z),
+			Categories:  imageStatuses(item.ItemImages),
+		}
+		if enclosures := ds.feedEnclosures(item.ItemImages); len(enclosures) > 0 {
+			converted.Enclosure = &rssEnclosure{Url: enclosures[0].Href, Type: enclosures[0].Type}
+		}
+		channel.Items = append(channel.Items, converted)
+	}
+	return rssFeed{Version: RSS_VERSION, Namespace: ATOM_NAMESPACE, Channel: channel}
+}
+
+//atom elements
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	Id       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle"`
+	Updated  string      `xml:"updated"`
+	Author   atomAuthor  `xml:"author"`
+	Link     atomLink    `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr,omitempty"`
+	Href   string `xml:"href,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length int64  `xml:"length,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Id         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Content    atomText       `xml:"content"`
+	Categories []atomCategory `xml:"category"`
+	Links      []atomLink     `xml:"link"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+//buildAtom lists content as an atom feed, every image of an item is enclosed
+func (ds *pgDataService) buildAtom(content feedContent, selfLink string) atomFeed {
+	feed := atomFeed{
+		Xmlns:    ATOM_NAMESPACE,
+		Id:       FEED_ID_PREFIX + "feed",
+		Title:    FEED_TITLE,
+		Subtitle: content.summary,
+		Updated:  content.updated.UTC().Format(time.RFC3339),
+		Author:   atomAuthor{Name: FEED_TITLE},
+		Link:     atomLink{Rel: SELF_LINK, Href: selfLink, Type: ATOM_CONTENT},
+		Entries:  []atomEntry{},
+	}
+	for _, item := range content.items {
+		entry := atomEntry{
+			Id:         feedItemId(item),
+			Title:      item.Summary,
+			Published:  item.CreatedAt.UTC().Format(time.RFC3339),
+			Updated:    item.UpdatedAt.UTC().Format(time.RFC3339),
+			Content:    atomText{Type: ATOM_TEXT_TYPE, Value: feedItemDescription(item)},
+			Categories: []atomCategory{},
+			Links:      ds.feedEnclosures(item.ItemImages),
+		}
+		for _, status := range imageStatuses(item.ItemImages) {
+			entry.Categories = append(entry.Categories, atomCategory{Term: status})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return feed
+}
+
+//feedEnclosures returns an enclosure link to the thumbnail of each distinct image in itemImages, linked through the path builder
+func (ds *pgDataService) feedEnclosures(itemImages []maddendb.ItemImages) []atomLink {
+	enclosures := []atomLink{}
+	seen := map[string]bool{}
+	for _, itemImage := range itemImages {
+		thumbnail := itemImage.MaddenImageFile.Thumbnail
+		if thumbnail == "" || seen[thumbnail] {
+			continue
+		}
+		seen[thumbnail] = true
+		enclosures = append(enclosures, atomLink{Rel: ENCLOSURE_LINK, Href: ds.appender.BuildFullPath(thumbnail), Type: thumbnailMimeType(thumbnail)})
+	}
+	return enclosures
+}
+
+//feedItemId returns the id of the feed item for item, it never changes for the life of the entry
+//occurrences of a recurring entry are told apart by where the rule places them
+func feedItemId(item maddendb.MaddenItem) string {
+	if item.OccurrenceStart != 0 {
+		return fmt.Sprintf("%sentry:%d:%d", FEED_ID_PREFIX, item.ID, item.OccurrenceStart)
+	}
+	return fmt.Sprintf("%sentry:%d", FEED_ID_PREFIX, item.ID)
+}
+
+//feedItemDescription describes the maintenance window of item followed by its details
+func feedItemDescription(item maddendb.MaddenItem) string {
+	window := fmt.Sprintf("From %s to %s", time.Unix(item.BeginDate, 0).UTC().Format(time.RFC3339), time.Unix(item.EndDate, 0).UTC().Format(time.RFC3339))
+	if item.Details == "" {
+		return window
+	}
+	return window + "\n\n" + item.Details
+}
+
+//thumbnailMimeType returns the type of a thumbnail from its extension, generated thumbnails are named for their encoding
+func thumbnailMimeType(thumbnail string) string {
+	if mimeType := mime.TypeByExtension(path.Ext(thumbnail)); mimeType != "" {
+		return mimeType
+	}
+	return FEED_MIME_TYPE
+}
+
+func latestTime(first, second time.Time) time.Time {
+	if second.After(first) {
+		return second
+	}
+	return first
+}
@@ -0,0 +1,222 @@
+package dataservice
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+	"gorm.io/gorm"
+)
+
+const testSelfLink = "https://madden.example/feed"
+
+//the subset of a marshalled rss feed checked by the tests
+type testRss struct {
+	Channel struct {
+		Description   string `xml:"description"`
+		LastBuildDate string `xml:"lastBuildDate"`
+		SelfLink      struct {
+			Rel  string `xml:"rel,attr"`
+			Href string `xml:"href,attr"`
+			Type string `xml:"type,attr"`
+		} `xml:"http://www.w3.org/2005/Atom link"`
+		Items []struct {
+			Title string `xml:"title"`
+			Guid  struct {
+				IsPermaLink string `xml:"isPermaLink,attr"`
+				Value       string `xml:",chardata"`
+			} `xml:"guid"`
+			Categories []string `xml:"category"`
+			Enclosure  struct {
+				Url string `xml:"url,attr"`
+			} `xml:"enclosure"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+//the subset of a marshalled atom feed checked by the tests
+type testAtom struct {
+	XMLName  xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+	Subtitle string   `xml:"subtitle"`
+	Updated  string   `xml:"updated"`
+	Links    []struct {
+		Rel  string `xml:"rel,attr"`
+		Href string `xml:"href,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"link"`
+	Entries []struct {
+		Id      string `xml:"id"`
+		Updated string `xml:"updated"`
+		Links   []struct {
+			Rel  string `xml:"rel,attr"`
+			Href string `xml:"href,attr"`
+		} `xml:"link"`
+	} `xml:"entry"`
+}
+
+//feedFixture returns the content of a feed listing a single entry and an occurrence of a recurring entry
+func feedFixture() feedContent {
+	updated := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	single := maddendb.MaddenItem{
+		Model:     gorm.Model{ID: 7, CreatedAt: updated.Add(-time.Hour), UpdatedAt: updated},
+		BeginDate: updated.Add(time.Hour).Unix(),
+		EndDate:   updated.Add(2 * time.Hour).Unix(),
+		Summary:   "pump swap",
+		ItemImages: []maddendb.ItemImages{
+			{Status: "NMC", MaddenImageFile: maddendb.MaddenImageFile{Thumbnail: "pump_thumb.png"}},
+			{Status: "PMC", MaddenImageFile: maddendb.MaddenImageFile{Thumbnail: "valve_thumb.png"}},
+		},
+	}
+	occurrence := maddendb.MaddenItem{
+		Model:           gorm.Model{ID: 9, CreatedAt: updated.Add(-2 * time.Hour), UpdatedAt: updated.Add(-time.Minute)},
+		BeginDate:       updated.Add(24 * time.Hour).Unix(),
+		EndDate:         updated.Add(25 * time.Hour).Unix(),
+		Summary:         "weekly check",
+		RRule:           "FREQ=WEEKLY",
+		OccurrenceStart: updated.Add(24 * time.Hour).Unix(),
+	}
+	return feedContent{summary: "all systems", updated: updated, items: []maddendb.MaddenItem{single, occurrence}}
+}
+
+func testFeedService(db maddendb.Madden) *pgDataService {
+	return &pgDataService{db: db, appender: utilities.NewSimpleAppender("https://madden.example/images/")}
+}
+
+func TestBuildRss(t *testing.T) {
+	content := feedFixture()
+	encoded, err := xml.Marshal(testFeedService(nil).buildRss(content, testSelfLink))
+	if err != nil {
+		t.Fatalf("unable to marshal rss ERROR: %s\n", err.Error())
+	}
+	parsed := testRss{}
+	if err := xml.Unmarshal(encoded, &parsed); err != nil {
+		t.Fatalf("unable to parse marshalled rss ERROR: %s\n%s\n", err.Error(), encoded)
+	}
+	channel := parsed.Channel
+	if channel.SelfLink.Rel != SELF_LINK || channel.SelfLink.Href != testSelfLink || channel.SelfLink.Type != RSS_CONTENT {
+		t.Errorf("expected atom self link to %s got %+v\n", testSelfLink, channel.SelfLink)
+	}
+	if channel.LastBuildDate != "Wed, 04 Mar 2026 05:06:07 +0000" {
+		t.Errorf("expected lastBuildDate from the latest update got %s\n", channel.LastBuildDate)
+	}
+	if channel.Description != "all systems" {
+		t.Errorf("expected the summary as description got %s\n", channel.Description)
+	}
+	expectedGuids := []string{"urn:madden:entry:7", "urn:madden:entry:9:" + fmt.Sprint(content.items[1].OccurrenceStart)}
+	if len(channel.Items) != len(expectedGuids) {
+		t.Fatalf("expected %d items got %d\n", len(expectedGuids), len(channel.Items))
+	}
+	for i, item := range channel.Items {
+		if item.Guid.Value != expectedGuids[i] || item.Guid.IsPermaLink != "false" {
+			t.Errorf("expected guid %s that is not a permalink got %+v\n", expectedGuids[i], item.Guid)
+		}
+	}
+	//rss allows a single enclosure, the first image is kept
+	if url := channel.Items[0].Enclosure.Url; url != "https://madden.example/images/pump_thumb.png" {
+		t.Errorf("expected the first thumbnail enclosed got %s\n", url)
+	}
+	if categories := strings.Join(channel.Items[0].Categories, ","); categories != "NMC,PMC" {
+		t.Errorf("expected image statuses as categories got %s\n", categories)
+	}
+}
+
+func TestBuildAtom(t *testing.T) {
+	content := feedFixture()
+	encoded, err := xml.Marshal(testFeedService(nil).buildAtom(content, testSelfLink))
+	if err != nil {
+		t.Fatalf("unable to marshal atom ERROR: %s\n", err.Error())
+	}
+	parsed := testAtom{}
+	if err := xml.Unmarshal(encoded, &parsed); err != nil {
+		t.Fatalf("unable to parse marshalled atom ERROR: %s\n%s\n", err.Error(), encoded)
+	}
+	if len(parsed.Links) != 1 || parsed.Links[0].Rel != SELF_LINK || parsed.Links[0].Href != testSelfLink || parsed.Links[0].Type != ATOM_CONTENT {
+		t.Errorf("expected a single self link to %s got %+v\n", testSelfLink, parsed.Links)
+	}
+	if parsed.Updated != "2026-03-04T05:06:07Z" {
+		t.Errorf("expected the feed updated at the latest update got %s\n", parsed.Updated)
+	}
+	expected := []struct {
+		id      string
+		updated string
+		links   int
+	}{
+		{id: "urn:madden:entry:7", updated: "2026-03-04T05:06:07Z", links: 2},
+		{id: "urn:madden:entry:9:" + fmt.Sprint(content.items[1].OccurrenceStart), updated: "2026-03-04T05:05:07Z", links: 0},
+	}
+	if len(parsed.Entries) != len(expected) {
+		t.Fatalf("expected %d entries got %d\n", len(expected), len(parsed.Entries))
+	}
+	for i, entry := range parsed.Entries {
+		if entry.Id != expected[i].id || entry.Updated != expected[i].updated {
+			t.Errorf("expected entry %s updated %s got %s updated %s\n", expected[i].id, expected[i].updated, entry.Id, entry.Updated)
+		}
+		if len(entry.Links) != expected[i].links {
+			t.Errorf("expected %d enclosures for %s got %d\n", expected[i].links, entry.Id, len(entry.Links))
+		}
+		for _, link := range entry.Links {
+			if link.Rel != ENCLOSURE_LINK {
+				t.Errorf("expected entry links to be enclosures got %s\n", link.Rel)
+			}
+		}
+	}
+}
+
+func TestGetFeedUnpublished(t *testing.T) {
+	ctx := context.Background()
+	db := maddendb.NewMemoryMadden()
+	if err := db.SetupDatabase(ctx); err != nil {
+		t.Fatalf("unable to setup database ERROR: %s\n", err.Error())
+	}
+	if _, err := db.CreateSummary(ctx, maddendb.Summary{Summary: "all systems"}); err != nil {
+		t.Fatalf("unable to create summary ERROR: %s\n", err.Error())
+	}
+	now := time.Now()
+	if _, err := db.CreateMaddenItem(ctx, maddendb.MaddenItem{BeginDate: now.Unix(), EndDate: now.Add(time.Hour).Unix(), Summary: "pump swap"}, false, "tester"); err != nil {
+		t.Fatalf("unable to create item ERROR: %s\n", err.Error())
+	}
+	ds := testFeedService(db)
+	tests := []struct {
+		name          string
+		published     bool
+		expectedItems int
+		expectedTitle string
+	}{
+		{name: "unpublished", published: false, expectedItems: 0, expectedTitle: FEED_NO_SUMMARY},
+		{name: "published", published: true, expectedItems: 1, expectedTitle: "all systems"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := db.CreatePublished(ctx, maddendb.Published{Published: test.published}); err != nil {
+				t.Fatalf("unable to create published ERROR: %s\n", err.Error())
+			}
+			rss, err := ds.GetFeed(ctx, FEED_RSS, testSelfLink)
+			if err != nil {
+				t.Fatalf("unable to get rss ERROR: %s\n", err.Error())
+			}
+			parsedRss := testRss{}
+			if err := xml.Unmarshal(rss, &parsedRss); err != nil {
+				t.Fatalf("unable to parse rss ERROR: %s\n", err.Error())
+			}
+			if len(parsedRss.Channel.Items) != test.expectedItems || parsedRss.Channel.Description != test.expectedTitle {
+				t.Errorf("expected %d rss items described %q got %d described %q\n", test.expectedItems, test.expectedTitle, len(parsedRss.Channel.Items), parsedRss.Channel.Description)
+			}
+			atom, err := ds.GetFeed(ctx, FEED_ATOM, testSelfLink)
+			if err != nil {
+				t.Fatalf("unable to get atom ERROR: %s\n", err.Error())
+			}
+			parsedAtom := testAtom{}
+			if err := xml.Unmarshal(atom, &parsedAtom); err != nil {
+				t.Fatalf("unable to parse atom ERROR: %s\n", err.Error())
+			}
+			if len(parsedAtom.Entries) != test.expectedItems || parsedAtom.Subtitle != test.expectedTitle {
+				t.Errorf("expected %d atom entries subtitled %q got %d subtitled %q\n", test.expectedItems, test.expectedTitle, len(parsedAtom.Entries), parsedAtom.Subtitle)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package dataservice
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/models"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+	"gorm.io/gorm"
+)
+
+//image library implementation of MaddenDataService
+
+func (ds *pgDataService) GetImages(ctx context.Context, params swagger.GetImageParams) (swagger.ImageFiles, error) {
+	if params.Cursor != nil {
+		return ds.getImagesAfter(ctx, params)
+	}
+	if params.Name != nil {
+		//names are matched literally, the data store matches by regular expression
+		images, err := ds.db.GetMaddenImagesByName(ctx, *params.PageNumber, *params.PageSize, regexp.QuoteMeta(*params.Name))
+		if err != nil {
+			return swagger.ImageFiles{}, logAndReturnError(ctx, err)
+		}
+		return swagger.ImageFiles{Images: ds.convertImageFiles(images)}, nil
+	}
+	images, err := ds.db.GetMaddenImages(ctx, *params.PageNumber, *params.PageSize)
+	if err != nil {
+		return swagger.ImageFiles{}, logAndReturnError(ctx, err)
+	}
+	page := swagger.ImageFiles{Images: ds.convertImageFiles(images)}
+	//a full page may be followed by more images
+	if len(images) > 0 && len(images) == *params.PageSize {
+		page.NextCursor = utilities.StrPtr(maddendb.NewImageCursor(images[len(images)-1]).Encode())
+	}
+	return page, nil
+}
+
+func (ds *pgDataService) CreateImage(ctx context.Context, image swagger.ImageFile) (swagger.ImageFile, error) {
+	defer ds.invalidateCache()
+	created, err := ds.db.CreateMaddenImage(ctx, swaggerToImageFile(image, 0))
+	if err != nil {
+		return swagger.ImageFile{}, logAndReturnError(ctx, err)
+	}
+	return ds.convertImageFile(created), nil
+}
+
+func (ds *pgDataService) UpdateImage(ctx context.Context, image swagger.ImageFile, expectedVersion int) (swagger.ImageFile, error) {
+	defer ds.invalidateCache()
+	_, updated, err := ds.db.UpdateMaddenImage(ctx, swaggerToImageFile(image, uint(image.Id)), uint(expectedVersion))
+	if err != nil {
+		return swagger.ImageFile{}, logAndReturnError(ctx, err)
+	}
+	return ds.convertImageFile(updated), nil
+}
+
+func (ds *pgDataService) DeleteImage(ctx context.Context, id int, cascade bool, actor string) error {
+	defer ds.invalidateCache()
+	if err := ds.db.DeleteMaddenImage(ctx, uint(id), cascade, actor); err != nil {
+		return logAndReturnError(ctx, err)
+	}
+	return nil
+}
+
+func (ds *pgDataService) GetImageUsages(ctx context.Context, id int) (swagger.ImageUsages, error) {
+	if _, err := ds.db.GetMaddenImageById(ctx, uint(id)); err != nil {
+		return swagger.ImageUsages{}, logAndReturnError(ctx, err)
+	}
+	items, err := ds.db.GetMaddenImageUsages(ctx, uint(id))
+	if err != nil {
+		return swagger.ImageUsages{}, logAndReturnError(ctx, err)
+	}
+	return swagger.ImageUsages{Entries: ds.convertToSwaggerModels(items)}, nil
+}
+
+//getImagesAfter returns the page following the cursor in params, one extra image is read to tell if another page follows
+func (ds *pgDataService) getImagesAfter(ctx context.Context, params swagger.GetImageParams) (swagger.ImageFiles, error) {
+	cursor, err := maddendb.DecodeImageCursor(*params.Cursor)
+	if err != nil {
+		return swagger.ImageFiles{}, models.NewDataServiceError(err.Error(), http.StatusBadRequest)
+	}
+	images, err := ds.db.GetMaddenImagesAfter(ctx, &cursor, *params.PageSize+1)
+	if err != nil {
+		return swagger.ImageFiles{}, logAndReturnError(ctx, err)
+	}
+	page := swagger.ImageFiles{}
+	if len(images) > *params.PageSize {
+		images = images[:*params.PageSize]
+		if len(images) > 0 {
+			page.NextCursor = utilities.StrPtr(maddendb.NewImageCursor(images[len(images)-1]).Encode())
+		}
+	}
+	page.Images = ds.convertImageFiles(images)
+	return page, nil
+}
+
+func (ds *pgDataService) convertImageFiles(images []maddendb.MaddenImageFile) []swagger.ImageFile {
+	converted := []swagger.ImageFile{}
+	for _, image := range images {
+		converted = append(converted, ds.convertImageFile(image))
+	}
+	return converted
+}
+
+func swaggerToImageFile(image swagger.ImageFile, id uint) maddendb.MaddenImageFile {
+	return maddendb.MaddenImageFile{
+		Model:     gorm.Model{ID: id},
+		FileName:  image.FileName,
+		Thumbnail: image.Thumbnail,
+	}
+}
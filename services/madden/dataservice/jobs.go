@@ -0,0 +1,27 @@
+package dataservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+)
+
+//background job implementation of MaddenDataService
+
+func (ds *pgDataService) GetHistoricizeRun(ctx context.Context) (swagger.HistoricizeRun, error) {
+	run, err := ds.db.GetJobRun(ctx, maddendb.JOB_HISTORICIZE)
+	if err != nil {
+		return swagger.HistoricizeRun{}, logAndReturnError(ctx, err)
+	}
+	converted := swagger.HistoricizeRun{Changed: int(run.Changed), TotalChanged: int(run.TotalChanged)}
+	if run.StartedAt.IsZero() {
+		return converted, nil
+	}
+	converted.StartedAt = utilities.StrPtr(run.StartedAt.UTC().Format(time.RFC3339))
+	converted.FinishedAt = utilities.StrPtr(run.FinishedAt.UTC().Format(time.RFC3339))
+	converted.Cutoff = utilities.StrPtr(run.Cutoff.UTC().Format(time.RFC3339))
+	return converted, nil
+}
@@ -1,10 +1,15 @@
 package dataservice
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
+	"github.com/PurplWarrior22/TestingCode/services/madden/blobstore"
 	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
 	"github.com/PurplWarrior22/TestingCode/services/maddendb"
 	"github.com/PurplWarrior22/TestingCode/services/models"
@@ -14,28 +19,101 @@ import (
 
 const (
 	HISTORIC = "historic"
+	//non standard status for a request abandoned by the client before a response was written
+	STATUS_CLIENT_CLOSED_REQUEST = 499
 )
 
 //defines an interface to interact with madden data
 type MaddenDataService interface {
-	//GetMaddenEntries returns a slice of maddenItem associated with the passed params, it assumes the validity of the params
-	GetMaddenEntries(params swagger.GetEntryParams) ([]swagger.MaddenItem, error)
+	//GetMaddenEntries returns a page of maddenItem associated with the passed params, it assumes the validity of the params other than the cursor
+	//pages are selected by cursor if one is passed, otherwise by page number, and include a cursor to the following page
+	GetMaddenEntries(ctx context.Context, params swagger.GetEntryParams) (swagger.MaddenItems, error)
 	//GetMaddenById returns a madden entry with the passed id
-	GetMaddenById(id int) (swagger.MaddenItem, error)
-	//CreateEntry creates a new madden item assuming the validity of the passed item
-	CreateEntry(swagger.MaddenItem) (swagger.MaddenItem, error)
-	//UpdateEntry updates the passed item, assuming the validity of the item
-	UpdateEntry(swagger.MaddenItem) (swagger.MaddenItem, error)
-	//DeleteEntry removes the madden item with an id
-	DeleteEntry(id int) (error)
+	GetMaddenById(ctx context.Context, id int) (swagger.MaddenItem, error)
+	//GetEntriesLastModified returns the latest time any entry was created, updated, deleted or restored, zero if there are no entries
+	GetEntriesLastModified(ctx context.Context) (time.Time, error)
+	//CreateEntry creates a new madden item assuming the validity of the passed item, the change is attributed to actor
+	//the created item lists its conflicts with other entries, with strict an item with conflicts is refused with an OverlapError
+	CreateEntry(ctx context.Context, item swagger.MaddenItem, strict bool, actor string) (swagger.MaddenItem, error)
+	//UpdateEntry updates the passed item, assuming the validity of the item, the change is attributed to actor
+	//fails with a 412 error if the stored version of the item is not expectedVersion, conflicts are handled as by CreateEntry
+	UpdateEntry(ctx context.Context, item swagger.MaddenItem, expectedVersion int, strict bool, actor string) (swagger.MaddenItem, error)
+	//SetEntryOccurrence edits or cancels a single occurrence of the recurring madden item with id, assuming the validity of the occurrence
+	//fails with a 412 error if the stored version of the item is not expectedVersion, the change is attributed to actor
+	//the conflicts of an edited occurrence are handled as by CreateEntry
+	SetEntryOccurrence(ctx context.Context, id int, occurrence swagger.EntryOccurrence, expectedVersion int, strict bool, actor string) (swagger.MaddenItem, error)
+	//DeleteEntry removes the madden item with an id, the change is attributed to actor
+	DeleteEntry(ctx context.Context, id int, actor string) (error)
+	//GetConflicts returns every conflict between entry windows in the range of params, assuming the validity of the params
+	GetConflicts(ctx context.Context, params swagger.GetConflictsParams) (swagger.Conflicts, error)
+	//GetStatus returns the status of every image at the instant of params, assuming the validity of the params
+	GetStatus(ctx context.Context, params swagger.GetStatusParams) (swagger.SystemStatus, error)
+	//GetAvailability returns the time every image spent in each status over the range of params, assuming the validity of the params
+	GetAvailability(ctx context.Context, params swagger.GetReportsAvailabilityParams) (swagger.AvailabilityReport, error)
+	//GetEntryHistory returns every revision of the madden item with id, oldest first
+	GetEntryHistory(ctx context.Context, id int) (swagger.EntryHistory, error)
+	//GetTrash returns a page of deleted madden items and a page of deleted images, most recently deleted first
+	GetTrash(ctx context.Context, pageNumber, pageSize int) (swagger.Trash, error)
+	//RestoreEntry revives the deleted madden item with id along with its images, the change is attributed to actor
+	//conflicts are handled as by CreateEntry
+	RestoreEntry(ctx context.Context, id int, strict bool, actor string) (swagger.MaddenItem, error)
+	//GetEntryRevisionDiff returns the fields changed between revisions from and to of the madden item with id
+	GetEntryRevisionDiff(ctx context.Context, id, from, to int) (swagger.RevisionDiff, error)
 	//CreateSummary creates a new summary or returns appropriate error
-	CreateSummary(swagger.Summary) (swagger.Summary, error)
+	CreateSummary(context.Context, swagger.Summary) (swagger.Summary, error)
 	//GetSummary gets the most recent summary or returns appropriate error
-	GetSummary() (swagger.Summary, error)
+	GetSummary(context.Context) (swagger.Summary, error)
 	//CreatePublished creates published state of madden
-	CreatePublished(swagger.Published) (swagger.Published, error)
+	CreatePublished(context.Context, swagger.Published) (swagger.Published, error)
 	//GetPublished gets the current published state of madden
-	GetPublished() (swagger.Published, error)
+	GetPublished(context.Context) (swagger.Published, error)
+	//GetImages returns a page of image files associated with the passed params, it assumes the validity of the params other than the cursor
+	//pages are selected by cursor if one is passed, otherwise by page number, unfiltered pages include a cursor to the following page
+	GetImages(ctx context.Context, params swagger.GetImageParams) (swagger.ImageFiles, error)
+	//CreateImage creates a new image file assuming the validity of the passed image
+	CreateImage(ctx context.Context, image swagger.ImageFile) (swagger.ImageFile, error)
+	//UpdateImage updates the passed image file, assuming its validity, failing with a 412 error if the stored version is not expectedVersion
+	UpdateImage(ctx context.Context, image swagger.ImageFile, expectedVersion int) (swagger.ImageFile, error)
+	//DeleteImage removes the image file with id, failing with a 409 error listing the entries that still use it unless cascade is set
+	//with cascade the image is removed from those entries first, each change is attributed to actor
+	DeleteImage(ctx context.Context, id int, cascade bool, actor string) error
+	//GetImageUsages returns the entries using the image file with id
+	GetImageUsages(ctx context.Context, id int) (swagger.ImageUsages, error)
+	//UploadImage stores a png, jpeg or gif image read from content under its SHA-256 along with a generated thumbnail and creates its image file
+	//content already uploaded returns the existing image file, the returned bool is true if the image file was created
+	//content of any other type fails with a 415 error
+	UploadImage(ctx context.Context, content io.Reader) (swagger.ImageFile, bool, error)
+	//Export writes every image, every entry, the current summary and the published state to out in format, ndjson or csv
+	//images are written before the entries linking them, nothing further is written once an error is returned
+	Export(ctx context.Context, format string, out io.Writer) error
+	//Import applies lines read by DecodeTransfer in a single transaction, mode skip leaves existing data matching a record unchanged and upsert updates it
+	//nothing is written if dryRun is set or any line fails, each failure is listed in the result, changes are attributed to actor
+	Import(ctx context.Context, lines []TransferLine, mode string, dryRun bool, actor string) (swagger.ImportResult, error)
+	//GetCalendar returns every maddenItem matching the date and historic filters of params as an iCalendar feed, it assumes the validity of the params
+	GetCalendar(ctx context.Context, params swagger.GetEntryParams) ([]byte, error)
+	//GetFeed returns the recent and upcoming maddenItems as an rss or atom feed announcing itself at selfLink
+	//the feed lists nothing while madden is unpublished
+	GetFeed(ctx context.Context, format string, selfLink string) ([]byte, error)
+	//CreateWebhook subscribes webhook to the events it names, every event if it names none, generating its secret if it has none
+	//the returned webhook is the only one to include the secret
+	CreateWebhook(ctx context.Context, webhook swagger.Webhook) (swagger.Webhook, error)
+	//GetWebhooks returns every webhook
+	GetWebhooks(ctx context.Context) (swagger.Webhooks, error)
+	//GetWebhookById returns the webhook with id
+	GetWebhookById(ctx context.Context, id int) (swagger.Webhook, error)
+	//DeleteWebhook removes the webhook with id
+	DeleteWebhook(ctx context.Context, id int) error
+	//GetWebhookDeliveries returns a page of the deliveries of the webhook with id, most recent first, limited to status unless it is empty
+	GetWebhookDeliveries(ctx context.Context, id int, status string, pageNumber, pageSize int) (swagger.WebhookDeliveries, error)
+	//RedeliverWebhookDelivery queues the delivery with deliveryId of the webhook with id to be attempted again immediately
+	RedeliverWebhookDelivery(ctx context.Context, id, deliveryId int) (swagger.WebhookDelivery, error)
+	//SubscribeEvents returns a channel receiving every change committed by any instance of the service, in commit order, until ctx is done
+	//if lastEventId is set the events after it are replayed first, the channel is closed if the subscriber falls too far behind
+	SubscribeEvents(ctx context.Context, lastEventId *uint) (<-chan Event, error)
+	//GetHistoricizeRun returns the latest run of the job marking ended entries historical, made by any instance of the service
+	GetHistoricizeRun(ctx context.Context) (swagger.HistoricizeRun, error)
+	//GetCacheStats returns the counters of the response cache of this instance
+	GetCacheStats(ctx context.Context) (swagger.CacheStats, error)
 }
 
 type pgDataService struct {
@@ -43,98 +121,298 @@ type pgDataService struct {
 	db maddendb.Madden
 	//used to build full link to images
 	appender utilities.PathBuilder
+	//where uploaded images and their thumbnails are stored, uploads are refused if nil
+	store blobstore.BlobStore
+	//the largest width or height of generated thumbnails
+	thumbnailSize int
+	//broadcasts change events to live subscribers
+	events *eventBroker
+	//holds entry lists, the summary and the published state until a write, responses are not cached if nil
+	cache ResponseCache
 }
 
-func NewPgDataService(db maddendb.Madden, appender utilities.PathBuilder) MaddenDataService {
-	return &pgDataService{db: db, appender: appender}
+func NewPgDataService(db maddendb.Madden, appender utilities.PathBuilder, store blobstore.BlobStore, thumbnailSize int, cache ResponseCache) MaddenDataService {
+	ds := &pgDataService{db: db, appender: appender, store: store, thumbnailSize: thumbnailSize, events: newEventBroker(db), cache: cache}
+	if cache != nil {
+		go ds.invalidateOnEvents()
+	}
+	return ds
 }
 
 //interface implementation
 
-func (ds *pgDataService) CreateSummary(summary swagger.Summary) (swagger.Summary, error) {
-	created, err := ds.db.CreateSummary(maddendb.Summary{Summary: summary.Summary})
+func (ds *pgDataService) CreateSummary(ctx context.Context, summary swagger.Summary) (swagger.Summary, error) {
+	defer ds.invalidateCache()
+	created, err := ds.db.CreateSummary(ctx, maddendb.Summary{Summary: summary.Summary})
 	if err != nil {
-		return summary, logAndReturnError(err)
+		return summary, logAndReturnError(ctx, err)
 	}
-	return swagger.Summary{Summary: created.Summary}, nil
+	return swagger.Summary{Summary: created.Summary, UpdatedAt: formatUpdatedAt(created.UpdatedAt)}, nil
 }
 
-func (ds *pgDataService) GetSummary() (swagger.Summary, error) {
-	summary, err := ds.db.GetSummary()
+func (ds *pgDataService) GetSummary(ctx context.Context) (swagger.Summary, error) {
+	summary, err := ds.cached(CACHE_KEY_SUMMARY, func() (interface{}, error) {
+		summary, err := ds.db.GetSummary(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return swagger.Summary{Summary: summary.Summary, UpdatedAt: formatUpdatedAt(summary.UpdatedAt)}, nil
+	})
 	if err != nil {
-		return swagger.Summary{}, logAndReturnError(err)
+		return swagger.Summary{}, logAndReturnError(ctx, err)
 	}
-	return swagger.Summary{Summary: summary.Summary}, nil
+	return summary.(swagger.Summary), nil
 }
 
-func (ds *pgDataService) CreatePublished(published swagger.Published) (swagger.Published, error) {
-	created, err := ds.db.CreatePublished(maddendb.Published{Published: published.Published})
+func (ds *pgDataService) CreatePublished(ctx context.Context, published swagger.Published) (swagger.Published, error) {
+	defer ds.invalidateCache()
+	created, err := ds.db.CreatePublished(ctx, maddendb.Published{Published: published.Published})
 	if err != nil {
-		return published, logAndReturnError(err)
+		return published, logAndReturnError(ctx, err)
 	}
-	return swagger.Published{Published: created.Published}, nil
+	return swagger.Published{Published: created.Published, UpdatedAt: formatUpdatedAt(created.UpdatedAt)}, nil
 }
 
-func (ds *pgDataService) GetPublished() (swagger.Published, error) {
-	published, err := ds.db.GetPublished()
+func (ds *pgDataService) GetPublished(ctx context.Context) (swagger.Published, error) {
+	published, err := ds.cached(CACHE_KEY_PUBLISHED, func() (interface{}, error) {
+		published, err := ds.db.GetPublished(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return swagger.Published{Published: published.Published, UpdatedAt: formatUpdatedAt(published.UpdatedAt)}, nil
+	})
 	if err != nil {
-		return swagger.Published{}, logAndReturnError(err)
+		return swagger.Published{}, logAndReturnError(ctx, err)
 	}
-	return swagger.Published{Published: published.Published}, nil
+	return published.(swagger.Published), nil
 }
 
-func (ds *pgDataService) GetMaddenEntries(params swagger.GetEntryParams) ([]swagger.MaddenItem, error) {
-	items, err := ds.db.GetMaddenItems(*params.PageNumber, *params.PageSize, convertTime(*params.StartDate), convertTime(*params.EndDate), convertToSortField(*params.Sort), historicBool(*params.Historic))
+func (ds *pgDataService) GetMaddenEntries(ctx context.Context, params swagger.GetEntryParams) (swagger.MaddenItems, error) {
+	page, err := ds.cached(entriesCacheKey(params), func() (interface{}, error) {
+		return ds.loadMaddenEntries(ctx, params)
+	})
 	if err != nil {
-		return nil, logAndReturnError(err)
+		return swagger.MaddenItems{}, err
 	}
-	return ds.convertToSwaggerModels(items), nil
+	return page.(swagger.MaddenItems), nil
+}
+
+//loadMaddenEntries reads the page of entries selected by params from the data store
+func (ds *pgDataService) loadMaddenEntries(ctx context.Context, params swagger.GetEntryParams) (swagger.MaddenItems, error) {
+	sortField := convertToSortField(*params.Sort)
+	if params.Q != nil {
+		return ds.searchMaddenEntries(ctx, params)
+	}
+	if params.Cursor != nil {
+		return ds.getMaddenEntriesAfter(ctx, params, sortField)
+	}
+	items, err := ds.db.GetMaddenItems(ctx, *params.PageNumber, *params.PageSize, convertTime(*params.StartDate), convertTime(*params.EndDate), sortField, historicBool(*params.Historic))
+	if err != nil {
+		return swagger.MaddenItems{}, logAndReturnError(ctx, err)
+	}
+	page := swagger.MaddenItems{Entries: ds.convertToSwaggerModels(items)}
+	//a full page may be followed by more entries
+	if len(items) > 0 && len(items) == *params.PageSize {
+		page.NextCursor = utilities.StrPtr(maddendb.NewItemCursor(items[len(items)-1], sortField).Encode())
+	}
+	return page, nil
 }
 
 func historicBool(param swagger.GetEntryParamsHistoric) bool {
 	return param == HISTORIC
 }
 
-func (ds *pgDataService) GetMaddenById(id int) (swagger.MaddenItem, error) {
-	item, err := ds.db.GetMaddenItemById(uint(id))
+func (ds *pgDataService) GetMaddenById(ctx context.Context, id int) (swagger.MaddenItem, error) {
+	item, err := ds.db.GetMaddenItemById(ctx, uint(id))
 	if err != nil {
-		return swagger.MaddenItem{}, logAndReturnError(err)
+		return swagger.MaddenItem{}, logAndReturnError(ctx, err)
 	}
 	return ds.convertSingleModel(item), nil
 }
 
-func (ds *pgDataService) CreateEntry(item swagger.MaddenItem) (swagger.MaddenItem, error) {
-	created, err := ds.db.CreateMaddenItem(swaggerToEntry(item, 0))
+func (ds *pgDataService) GetEntriesLastModified(ctx context.Context) (time.Time, error) {
+	lastModified, err := ds.cached(CACHE_KEY_ENTRIES_MODIFIED, func() (interface{}, error) {
+		return ds.db.GetMaddenItemsLastModified(ctx)
+	})
 	if err != nil {
-		return swagger.MaddenItem{}, logAndReturnError(err)
+		return time.Time{}, logAndReturnError(ctx, err)
 	}
-	return ds.convertSingleModel(created), nil
+	return lastModified.(time.Time), nil
 }
 
-func (ds *pgDataService) UpdateEntry(item swagger.MaddenItem) (swagger.MaddenItem, error) {
-	updated, err := ds.db.UpdateMaddenItem(swaggerToEntry(item, uint(*item.Id)))
+func (ds *pgDataService) CreateEntry(ctx context.Context, item swagger.MaddenItem, strict bool, actor string) (swagger.MaddenItem, error) {
+	defer ds.invalidateCache()
+	created, err := ds.db.CreateMaddenItem(ctx, swaggerToEntry(item, 0), strict, actor)
 	if err != nil {
-		return swagger.MaddenItem{}, logAndReturnError(err)
+		return swagger.MaddenItem{}, logAndReturnError(ctx, err)
 	}
-	return ds.convertSingleModel(updated), nil
+	return ds.withConflicts(created), nil
 }
 
-func (ds *pgDataService) DeleteEntry(id int) (error) {
-	deleted := ds.db.DeleteMaddenItem(uint(id))
+func (ds *pgDataService) UpdateEntry(ctx context.Context, item swagger.MaddenItem, expectedVersion int, strict bool, actor string) (swagger.MaddenItem, error) {
+	defer ds.invalidateCache()
+	updated, err := ds.db.UpdateMaddenItem(ctx, swaggerToEntry(item, uint(*item.Id)), uint(expectedVersion), strict, actor)
+	if err != nil {
+		return swagger.MaddenItem{}, logAndReturnError(ctx, err)
+	}
+	return ds.withConflicts(updated), nil
+}
+
+func (ds *pgDataService) SetEntryOccurrence(ctx context.Context, id int, occurrence swagger.EntryOccurrence, expectedVersion int, strict bool, actor string) (swagger.MaddenItem, error) {
+	defer ds.invalidateCache()
+	updated, err := ds.db.SetMaddenItemOccurrence(ctx, uint(id), swaggerToOccurrence(occurrence), uint(expectedVersion), strict, actor)
+	if err != nil {
+		return swagger.MaddenItem{}, logAndReturnError(ctx, err)
+	}
+	return ds.withConflicts(updated), nil
+}
+
+func (ds *pgDataService) DeleteEntry(ctx context.Context, id int, actor string) (error) {
+	defer ds.invalidateCache()
+	deleted := ds.db.DeleteMaddenItem(ctx, uint(id), actor)
 	if deleted != nil {
-		return logAndReturnError(deleted)
+		return logAndReturnError(ctx, deleted)
 	}
 
 	return nil
 }
 
+func (ds *pgDataService) GetTrash(ctx context.Context, pageNumber, pageSize int) (swagger.Trash, error) {
+	items, err := ds.db.GetDeletedMaddenItems(ctx, pageNumber, pageSize)
+	if err != nil {
+		return swagger.Trash{}, logAndReturnError(ctx, err)
+	}
+	images, err := ds.db.GetDeletedMaddenImages(ctx, pageNumber, pageSize)
+	if err != nil {
+		return swagger.Trash{}, logAndReturnError(ctx, err)
+	}
+	trash := swagger.Trash{Entries: []swagger.TrashedEntry{}, Images: []swagger.TrashedImage{}}
+	for _, item := range items {
+		trash.Entries = append(trash.Entries, swagger.TrashedEntry{
+			DeletedAt: item.DeletedAt.Time.UTC().Format(time.RFC3339),
+			Entry:     ds.convertSingleModel(item),
+		})
+	}
+	for _, image := range images {
+		trash.Images = append(trash.Images, swagger.TrashedImage{
+			DeletedAt: image.DeletedAt.Time.UTC().Format(time.RFC3339),
+			Image:     ds.convertImageFile(image),
+		})
+	}
+	return trash, nil
+}
+
+func (ds *pgDataService) RestoreEntry(ctx context.Context, id int, strict bool, actor string) (swagger.MaddenItem, error) {
+	defer ds.invalidateCache()
+	restored, err := ds.db.RestoreMaddenItem(ctx, uint(id), strict, actor)
+	if err != nil {
+		return swagger.MaddenItem{}, logAndReturnError(ctx, err)
+	}
+	return ds.withConflicts(restored), nil
+}
+
+func (ds *pgDataService) GetEntryHistory(ctx context.Context, id int) (swagger.EntryHistory, error) {
+	revisions, err := ds.db.GetMaddenItemRevisions(ctx, uint(id))
+	if err != nil {
+		return swagger.EntryHistory{}, logAndReturnError(ctx, err)
+	}
+	history := swagger.EntryHistory{Revisions: []swagger.EntryRevision{}}
+	for _, revision := range revisions {
+		converted, err := ds.convertRevision(revision)
+		if err != nil {
+			return swagger.EntryHistory{}, logAndReturnError(ctx, err)
+		}
+		history.Revisions = append(history.Revisions, converted)
+	}
+	return history, nil
+}
+
+func (ds *pgDataService) GetEntryRevisionDiff(ctx context.Context, id, from, to int) (swagger.RevisionDiff, error) {
+	fromSnapshot, err := ds.revisionSnapshot(ctx, id, from)
+	if err != nil {
+		return swagger.RevisionDiff{}, err
+	}
+	toSnapshot, err := ds.revisionSnapshot(ctx, id, to)
+	if err != nil {
+		return swagger.RevisionDiff{}, err
+	}
+	return swagger.RevisionDiff{
+		From:    from,
+		To:      to,
+		Changes: ds.convertChanges(maddendb.DiffSnapshots(fromSnapshot, toSnapshot)),
+	}, nil
+}
+
 //helpers
-func logAndReturnError(err error) error {
+
+//getMaddenEntriesAfter returns the page following the cursor in params, one extra item is read to tell if another page follows
+func (ds *pgDataService) getMaddenEntriesAfter(ctx context.Context, params swagger.GetEntryParams, sortField maddendb.SortField) (swagger.MaddenItems, error) {
+	cursor, err := maddendb.DecodeItemCursor(*params.Cursor)
+	if err != nil {
+		return swagger.MaddenItems{}, models.NewDataServiceError(err.Error(), http.StatusBadRequest)
+	}
+	if cursor.SortField != sortField {
+		return swagger.MaddenItems{}, models.NewDataServiceError("cursor was not built for the requested sort", http.StatusBadRequest)
+	}
+	items, err := ds.db.GetMaddenItemsAfter(ctx, &cursor, *params.PageSize+1, convertTime(*params.StartDate), convertTime(*params.EndDate), sortField, historicBool(*params.Historic))
+	if err != nil {
+		return swagger.MaddenItems{}, logAndReturnError(ctx, err)
+	}
+	page := swagger.MaddenItems{}
+	if len(items) > *params.PageSize {
+		items = items[:*params.PageSize]
+		if len(items) > 0 {
+			page.NextCursor = utilities.StrPtr(maddendb.NewItemCursor(items[len(items)-1], sortField).Encode())
+		}
+	}
+	page.Entries = ds.convertToSwaggerModels(items)
+	return page, nil
+}
+
+//searchMaddenEntries returns a page of entries matching the search in params, best match first
+func (ds *pgDataService) searchMaddenEntries(ctx context.Context, params swagger.GetEntryParams) (swagger.MaddenItems, error) {
+	if _, err := maddendb.ParseSearchQuery(*params.Q); err != nil {
+		return swagger.MaddenItems{}, models.NewDataServiceError(err.Error(), http.StatusBadRequest)
+	}
+	results, err := ds.db.SearchMaddenItems(ctx, *params.Q, *params.PageNumber, *params.PageSize, convertTime(*params.StartDate), convertTime(*params.EndDate), historicBool(*params.Historic))
+	if err != nil {
+		return swagger.MaddenItems{}, logAndReturnError(ctx, err)
+	}
+	page := swagger.MaddenItems{Entries: []swagger.MaddenItem{}}
+	for _, result := range results {
+		converted := ds.convertSingleModel(result.Item)
+		rank := float32(result.Rank)
+		converted.Rank = &rank
+		converted.Highlights = &swagger.SearchHighlights{Summary: result.SummarySnippet, Details: result.DetailsSnippet}
+		page.Entries = append(page.Entries, converted)
+	}
+	return page, nil
+}
+
+func logAndReturnError(ctx context.Context, err error) error {
 	fmt.Printf("Error during database action ERROR: %s\n", err.Error())
+	//the driver reports an abandoned query in several ways, the request context says why it was abandoned
+	if errors.Is(err, context.Canceled) || ctx.Err() == context.Canceled {
+		return models.NewDataServiceError("request cancelled by the client", STATUS_CLIENT_CLOSED_REQUEST)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || ctx.Err() == context.DeadlineExceeded {
+		return models.NewDataServiceError("request did not complete before its deadline", http.StatusGatewayTimeout)
+	}
 	switch converted := err.(type) {
 	case *maddendb.DbError:
 		fmt.Println(converted.OriginalError)
+		if converted.OriginalError == gorm.ErrRecordNotFound {
+			return models.NewDataServiceError(err.Error(), http.StatusNotFound)
+		}
 		return models.NewDataServiceError(err.Error(), http.StatusInternalServerError)
+	case *maddendb.ConflictError:
+		return models.NewConflictError(err.Error(), converted.ExistingId)
+	case *maddendb.VersionConflictError:
+		return models.NewDataServiceError(err.Error(), http.StatusPreconditionFailed)
+	case *maddendb.OverlapError:
+		return overlapError(converted)
+	case *maddendb.ImageInUseError:
+		return models.NewImageInUseError(err.Error(), converted.ItemIds)
 	default:
 		return err
 	}
@@ -149,7 +427,7 @@ func (ds *pgDataService) convertToSwaggerModels(items []maddendb.MaddenItem) []s
 }
 
 func (ds *pgDataService) convertSingleModel(item maddendb.MaddenItem) swagger.MaddenItem {
-	return swagger.MaddenItem{
+	converted := swagger.MaddenItem{
 		Details:    item.Details,
 		Summary:    item.Summary,
 		EndDate:    time.Unix(item.EndDate, 0).UTC().Format(time.RFC3339),
@@ -157,7 +435,49 @@ func (ds *pgDataService) convertSingleModel(item maddendb.MaddenItem) swagger.Ma
 		Historical: &item.IsHistorical,
 		Id:         uintPtr(int(item.ID)),
 		Images:     ds.convertToSwaggerImages(item.ItemImages),
+		UpdatedAt:  formatUpdatedAt(item.UpdatedAt),
+		Version:    uintPtr(int(item.Version)),
+	}
+	if item.RRule != "" {
+		converted.Rrule = utilities.StrPtr(item.RRule)
+	}
+	//expanded occurrences identify themselves by their place in the series, their exceptions stay with the series
+	if item.OccurrenceStart != 0 {
+		converted.OccurrenceStart = utilities.StrPtr(formatTime(item.OccurrenceStart))
+	} else if len(item.Occurrences) > 0 {
+		exceptions := convertOccurrences(item.Occurrences)
+		converted.Exceptions = &exceptions
+	}
+	return converted
+}
+
+func convertOccurrences(occurrences []maddendb.ItemOccurrence) []swagger.EntryOccurrence {
+	converted := []swagger.EntryOccurrence{}
+	for _, occurrence := range occurrences {
+		converted = append(converted, convertOccurrence(maddendb.OccurrenceSnapshot{
+			OccurrenceStart: occurrence.OccurrenceStart,
+			Cancelled:       occurrence.Cancelled,
+			BeginDate:       occurrence.BeginDate,
+			EndDate:         occurrence.EndDate,
+			Summary:         occurrence.Summary,
+			Details:         occurrence.Details,
+		}))
+	}
+	return converted
+}
+
+func convertOccurrence(occurrence maddendb.OccurrenceSnapshot) swagger.EntryOccurrence {
+	converted := swagger.EntryOccurrence{
+		OccurrenceStart: formatTime(occurrence.OccurrenceStart),
+		Cancelled:       occurrence.Cancelled,
+	}
+	if !occurrence.Cancelled {
+		converted.StartDate = utilities.StrPtr(formatTime(occurrence.BeginDate))
+		converted.EndDate = utilities.StrPtr(formatTime(occurrence.EndDate))
+		converted.Summary = utilities.StrPtr(occurrence.Summary)
+		converted.Details = utilities.StrPtr(occurrence.Details)
 	}
+	return converted
 }
 
 func (ds *pgDataService) convertToSwaggerImages(images []maddendb.ItemImages) []swagger.MaddenImage {
@@ -173,6 +493,166 @@ func (ds *pgDataService) convertToSwaggerImages(images []maddendb.ItemImages) []
 	return converted
 }
 
+func (ds *pgDataService) convertImageFile(image maddendb.MaddenImageFile) swagger.ImageFile {
+	converted := swagger.ImageFile{
+		Id:            int(image.ID),
+		FileName:      image.FileName,
+		Thumbnail:     image.Thumbnail,
+		ImageLink:     utilities.StrPtr(ds.appender.BuildFullPath(image.FileName)),
+		ThumbnailLink: utilities.StrPtr(ds.appender.BuildFullPath(image.Thumbnail)),
+		Version:       uintPtr(int(image.Version)),
+	}
+	//images registered without uploading their content have no content details
+	if image.ContentHash != "" {
+		converted.ContentHash = utilities.StrPtr(image.ContentHash)
+		converted.Size = &image.Size
+		converted.MimeType = utilities.StrPtr(image.MimeType)
+		converted.Width = utilities.IntPtr(image.Width)
+		converted.Height = utilities.IntPtr(image.Height)
+	}
+	return converted
+}
+
+//revisionSnapshot returns the item state recorded by a single revision
+func (ds *pgDataService) revisionSnapshot(ctx context.Context, id, revision int) (maddendb.ItemSnapshot, error) {
+	found, err := ds.db.GetMaddenItemRevision(ctx, uint(id), uint(revision))
+	if err != nil {
+		return maddendb.ItemSnapshot{}, logAndReturnError(ctx, err)
+	}
+	snapshot, err := found.GetSnapshot()
+	if err != nil {
+		return maddendb.ItemSnapshot{}, logAndReturnError(ctx, err)
+	}
+	return snapshot, nil
+}
+
+func (ds *pgDataService) convertRevision(revision maddendb.ItemRevision) (swagger.EntryRevision, error) {
+	snapshot, err := revision.GetSnapshot()
+	if err != nil {
+		return swagger.EntryRevision{}, err
+	}
+	changes, err := revision.GetChanges()
+	if err != nil {
+		return swagger.EntryRevision{}, err
+	}
+	return swagger.EntryRevision{
+		Action:    swagger.EntryRevisionAction(revision.Action),
+		Actor:     revision.Actor,
+		Changes:   ds.convertChanges(changes),
+		Deleted:   snapshot.Deleted,
+		Entry:     ds.convertSnapshot(revision.MaddenItemId, snapshot),
+		Revision:  int(revision.Revision),
+		Timestamp: revision.CreatedAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func (ds *pgDataService) convertSnapshot(id uint, snapshot maddendb.ItemSnapshot) swagger.MaddenItem {
+	converted := swagger.MaddenItem{
+		Details:    snapshot.Details,
+		Summary:    snapshot.Summary,
+		EndDate:    formatTime(snapshot.EndDate),
+		StartDate:  formatTime(snapshot.BeginDate),
+		Historical: &snapshot.IsHistorical,
+		Id:         uintPtr(int(id)),
+		Images:     ds.convertSnapshotImages(snapshot.Images),
+	}
+	if snapshot.RRule != "" {
+		converted.Rrule = utilities.StrPtr(snapshot.RRule)
+	}
+	if len(snapshot.Exceptions) > 0 {
+		exceptions := convertSnapshotOccurrences(snapshot.Exceptions)
+		converted.Exceptions = &exceptions
+	}
+	return converted
+}
+
+func convertSnapshotOccurrences(occurrences []maddendb.OccurrenceSnapshot) []swagger.EntryOccurrence {
+	converted := []swagger.EntryOccurrence{}
+	for _, occurrence := range occurrences {
+		converted = append(converted, convertOccurrence(occurrence))
+	}
+	return converted
+}
+
+func (ds *pgDataService) convertSnapshotImages(images []maddendb.ImageSnapshot) []swagger.MaddenImage {
+	converted := []swagger.MaddenImage{}
+	for _, image := range images {
+		converted = append(converted, swagger.MaddenImage{
+			Id:            int(image.MaddenImageFileId),
+			ImageLink:     utilities.StrPtr(ds.appender.BuildFullPath(image.FileName)),
+			ThumbnailLink: utilities.StrPtr(ds.appender.BuildFullPath(image.Thumbnail)),
+			Status:        swagger.MaddenImageStatus(image.Status),
+		})
+	}
+	return converted
+}
+
+//convertChanges converts revision changes to their api form, dates are formatted as RFC3339 and images as api images
+func (ds *pgDataService) convertChanges(changes []maddendb.FieldChange) []swagger.FieldChange {
+	converted := []swagger.FieldChange{}
+	for _, change := range changes {
+		from, to := change.From, change.To
+		switch change.Field {
+		case maddendb.FIELD_START_DATE, maddendb.FIELD_END_DATE:
+			from, to = formatChangedTime(from), formatChangedTime(to)
+		case maddendb.FIELD_IMAGES:
+			from, to = ds.convertChangedImages(from), ds.convertChangedImages(to)
+		case maddendb.FIELD_EXCEPTIONS:
+			from, to = convertChangedOccurrences(from), convertChangedOccurrences(to)
+		}
+		converted = append(converted, swagger.FieldChange{Field: change.Field, From: from, To: to})
+	}
+	return converted
+}
+
+//convertChangedImages converts images recorded in a change, changes read back from the database hold decoded json rather than ImageSnapshots
+func (ds *pgDataService) convertChangedImages(value interface{}) interface{} {
+	images, ok := value.([]maddendb.ImageSnapshot)
+	if !ok {
+		encoded, err := json.Marshal(value)
+		if err != nil || json.Unmarshal(encoded, &images) != nil {
+			return value
+		}
+	}
+	return ds.convertSnapshotImages(images)
+}
+
+//convertChangedOccurrences converts exceptions recorded in a change, like convertChangedImages
+func convertChangedOccurrences(value interface{}) interface{} {
+	occurrences, ok := value.([]maddendb.OccurrenceSnapshot)
+	if !ok {
+		encoded, err := json.Marshal(value)
+		if err != nil || json.Unmarshal(encoded, &occurrences) != nil {
+			return value
+		}
+	}
+	return convertSnapshotOccurrences(occurrences)
+}
+
+//formatChangedTime formats a unix time recorded in a change, changes read back from the database hold json numbers
+func formatChangedTime(value interface{}) interface{} {
+	switch converted := value.(type) {
+	case int64:
+		return formatTime(converted)
+	case float64:
+		return formatTime(int64(converted))
+	default:
+		return value
+	}
+}
+
+func formatTime(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
+
+//formatUpdatedAt formats the time of a change, nil if there was none
+func formatUpdatedAt(updatedAt time.Time) *string {
+	if updatedAt.IsZero() {
+		return nil
+	}
+	return utilities.StrPtr(updatedAt.UTC().Format(time.RFC3339))
+}
+
 func convertTime(dateString string) int64 {
 	//safe to assume time validity
 	timeObj, _ := time.Parse(time.RFC3339, dateString)
@@ -197,9 +677,24 @@ func swaggerToEntry(item swagger.MaddenItem, id uint) maddendb.MaddenItem {
 		BeginDate:    convertTime(item.StartDate),
 		EndDate:      convertTime(item.EndDate),
 		ItemImages:   swaggerToImages(item.Images, id),
+		RRule:        maddendb.NormalizeRRule(nullSafeString(item.Rrule)),
 	}
 }
 
+func swaggerToOccurrence(occurrence swagger.EntryOccurrence) maddendb.ItemOccurrence {
+	converted := maddendb.ItemOccurrence{
+		OccurrenceStart: convertTime(occurrence.OccurrenceStart),
+		Cancelled:       occurrence.Cancelled,
+	}
+	if !occurrence.Cancelled {
+		converted.BeginDate = convertTime(nullSafeString(occurrence.StartDate))
+		converted.EndDate = convertTime(nullSafeString(occurrence.EndDate))
+		converted.Summary = nullSafeString(occurrence.Summary)
+		converted.Details = nullSafeString(occurrence.Details)
+	}
+	return converted
+}
+
 func swaggerToImages(images []swagger.MaddenImage, itemId uint) []maddendb.ItemImages {
 	itemImages := []maddendb.ItemImages{}
 	for _, image := range images {
@@ -218,6 +713,13 @@ func nullSafeHistoric(item *bool) bool {
 	return *item
 }
 
+func nullSafeString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
 func uintPtr(i int) *int {
 	return &i
 }
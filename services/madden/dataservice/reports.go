@@ -0,0 +1,156 @@
+package dataservice
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+)
+
+//availability reporting over the status history of every image
+
+const (
+	GRANULARITY_DAY   = "day"
+	GRANULARITY_WEEK  = "week"
+	GRANULARITY_MONTH = "month"
+	REPORT_JSON       = "json"
+	REPORT_CSV        = "csv"
+	//scopes of availability csv rows, a row covers a single period or the whole report
+	REPORT_SCOPE_PERIOD = "period"
+	REPORT_SCOPE_TOTAL  = "total"
+)
+
+var (
+	//columns of an availability csv, one row per image in each period followed by one total row per image
+	availabilityColumns = []string{"scope", "start", "end", "imageId", "fileName", "fmcSeconds", "pmcSeconds", "nmcSeconds", "fmcPercent", "pmcPercent", "nmcPercent"}
+)
+
+//GetAvailability reports the time every image spent in each status between params.From and params.To, split at each granularity boundary
+//params are expected to be valid with the granularity filled
+func (ds *pgDataService) GetAvailability(ctx context.Context, params swagger.GetReportsAvailabilityParams) (swagger.AvailabilityReport, error) {
+	from, _ := time.Parse(time.RFC3339, params.From)
+	to, _ := time.Parse(time.RFC3339, params.To)
+	periods, err := ds.db.GetMaddenAvailability(ctx, AvailabilityBoundaries(from, to, string(*params.Granularity)))
+	if err != nil {
+		return swagger.AvailabilityReport{}, logAndReturnError(ctx, err)
+	}
+	report := swagger.AvailabilityReport{
+		From:        formatTime(from.Unix()),
+		Granularity: string(*params.Granularity),
+		Periods:     []swagger.AvailabilityPeriod{},
+		To:          formatTime(to.Unix()),
+		Totals:      []swagger.ImageAvailability{},
+	}
+	//every period holds the same images in the same order
+	totals := []maddendb.ImageAvailability{}
+	for _, period := range periods {
+		converted := swagger.AvailabilityPeriod{
+			End:    formatTime(period.End),
+			Images: []swagger.ImageAvailability{},
+			Start:  formatTime(period.Start),
+		}
+		for i, image := range period.Images {
+			converted.Images = append(converted.Images, ds.convertImageAvailability(image))
+			if len(totals) <= i {
+				totals = append(totals, maddendb.ImageAvailability{MaddenImageFile: image.MaddenImageFile})
+			}
+			totals[i].FMC += image.FMC
+			totals[i].PMC += image.PMC
+			totals[i].NMC += image.NMC
+		}
+		report.Periods = append(report.Periods, converted)
+	}
+	for _, total := range totals {
+		report.Totals = append(report.Totals, ds.convertImageAvailability(total))
+	}
+	return report, nil
+}
+
+//AvailabilityBoundaries splits from to to at the start of every utc day, week or month between them, weeks start on monday
+func AvailabilityBoundaries(from, to time.Time, granularity string) []int64 {
+	boundaries := []int64{from.Unix()}
+	for next := nextBoundary(from.UTC(), granularity); next.Before(to); next = nextBoundary(next, granularity) {
+		boundaries = append(boundaries, next.Unix())
+	}
+	return append(boundaries, to.Unix())
+}
+
+//nextBoundary returns the start of the utc day, week or month after the one holding instant
+func nextBoundary(instant time.Time, granularity string) time.Time {
+	year, month, day := instant.Date()
+	switch granularity {
+	case GRANULARITY_DAY:
+		return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+	case GRANULARITY_WEEK:
+		//days until the next monday, a full week from a monday
+		return time.Date(year, month, day+7-(int(instant.Weekday())+6)%7, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (ds *pgDataService) convertImageAvailability(availability maddendb.ImageAvailability) swagger.ImageAvailability {
+	length := availability.FMC + availability.PMC + availability.NMC
+	return swagger.ImageAvailability{
+		Image: ds.convertImageFile(availability.MaddenImageFile),
+		Percentages: swagger.StatusPercentages{
+			FMC: percentage(availability.FMC, length),
+			NMC: percentage(availability.NMC, length),
+			PMC: percentage(availability.PMC, length),
+		},
+		Seconds: swagger.StatusSeconds{
+			FMC: availability.FMC,
+			NMC: availability.NMC,
+			PMC: availability.PMC,
+		},
+	}
+}
+
+//percentage returns part as a percentage of whole rounded to two decimal places
+func percentage(part, whole int64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(float64(part)*10000/float64(whole)) / 100
+}
+
+//WriteAvailabilityCsv writes report as rows of availabilityColumns, the rows of every period followed by the totals
+func WriteAvailabilityCsv(report swagger.AvailabilityReport, out io.Writer) error {
+	writer := csv.NewWriter(out)
+	if err := writer.Write(availabilityColumns); err != nil {
+		return err
+	}
+	rows := [][]string{}
+	for _, period := range report.Periods {
+		for _, image := range period.Images {
+			rows = append(rows, availabilityRow(REPORT_SCOPE_PERIOD, period.Start, period.End, image))
+		}
+	}
+	for _, image := range report.Totals {
+		rows = append(rows, availabilityRow(REPORT_SCOPE_TOTAL, report.From, report.To, image))
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+func availabilityRow(scope, start, end string, image swagger.ImageAvailability) []string {
+	return []string{
+		scope,
+		start,
+		end,
+		strconv.Itoa(image.Image.Id),
+		image.Image.FileName,
+		strconv.FormatInt(image.Seconds.FMC, 10),
+		strconv.FormatInt(image.Seconds.PMC, 10),
+		strconv.FormatInt(image.Seconds.NMC, 10),
+		strconv.FormatFloat(image.Percentages.FMC, 'f', 2, 64),
+		strconv.FormatFloat(image.Percentages.PMC, 'f', 2, 64),
+		strconv.FormatFloat(image.Percentages.NMC, 'f', 2, 64),
+	}
+}
@@ -0,0 +1,57 @@
+package dataservice
+
+import (
+	"context"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+)
+
+//image status rollup
+
+func (ds *pgDataService) GetStatus(ctx context.Context, params swagger.GetStatusParams) (swagger.SystemStatus, error) {
+	status, err := ds.db.GetMaddenStatus(ctx, convertTime(*params.At))
+	if err != nil {
+		return swagger.SystemStatus{}, logAndReturnError(ctx, err)
+	}
+	converted := swagger.SystemStatus{
+		At:     formatTime(status.At),
+		Images: []swagger.ImageStatus{},
+		Status: swagger.MaddenImageStatus(status.Status),
+	}
+	for _, image := range status.Images {
+		converted.Images = append(converted.Images, ds.convertImageStatus(image))
+		switch image.Status {
+		case maddendb.STATUS_NMC:
+			converted.Counts.NMC++
+		case maddendb.STATUS_PMC:
+			converted.Counts.PMC++
+		default:
+			converted.Counts.FMC++
+		}
+	}
+	return converted, nil
+}
+
+func (ds *pgDataService) convertImageStatus(status maddendb.ImageStatus) swagger.ImageStatus {
+	converted := swagger.ImageStatus{
+		Entries: []swagger.StatusEntry{},
+		Image:   ds.convertImageFile(status.MaddenImageFile),
+		Status:  swagger.MaddenImageStatus(status.Status),
+	}
+	for _, window := range status.Windows {
+		entry := swagger.StatusEntry{
+			EndDate:   formatTime(window.Item.EndDate),
+			Id:        int(window.Item.ID),
+			StartDate: formatTime(window.Item.BeginDate),
+			Status:    swagger.MaddenImageStatus(window.Status),
+			Summary:   window.Item.Summary,
+		}
+		if window.Item.OccurrenceStart != 0 {
+			entry.OccurrenceStart = utilities.StrPtr(formatTime(window.Item.OccurrenceStart))
+		}
+		converted.Entries = append(converted.Entries, entry)
+	}
+	return converted
+}
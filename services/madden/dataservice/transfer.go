@@ -0,0 +1,475 @@
+package dataservice
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/models"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+	"gorm.io/gorm"
+)
+
+//bulk export and import implementation of MaddenDataService
+
+const (
+	TRANSFER_NDJSON = "ndjson"
+	TRANSFER_CSV    = "csv"
+	IMPORT_SKIP     = "skip"
+	IMPORT_UPSERT   = "upsert"
+	//rows read from the data store per query while exporting
+	EXPORT_PAGE_SIZE = 500
+	//separates the images of an entry in the csv images column, each image is written as id:status
+	CSV_IMAGE_SEPARATOR = ";"
+)
+
+var (
+	//columns of a csv export, each record fills the columns of its type and leaves the rest empty
+	//exports written before entries could recur lack the final rrule column and are still imported
+	csvColumns = []string{"type", "id", "fileName", "thumbnail", "contentHash", "size", "mimeType", "width", "height", "startDate", "endDate", "summary", "details", "historical", "images", "published", "rrule"}
+)
+
+//TransferLine is a record read from an import and the line it was read from
+type TransferLine struct {
+	Line   int
+	Record swagger.TransferRecord
+}
+
+//transferWriter writes the records of an export in a single format
+type transferWriter interface {
+	write(record swagger.TransferRecord) error
+	//flush writes any buffered records
+	flush() error
+}
+
+func (ds *pgDataService) Export(ctx context.Context, format string, out io.Writer) error {
+	formatWriter, err := newTransferWriter(format, out)
+	if err != nil {
+		return models.NewDataServiceError(err.Error(), http.StatusBadRequest)
+	}
+	writer := &countingWriter{transferWriter: formatWriter}
+	if err := ds.exportRecords(ctx, writer); err != nil {
+		//once records are written the status can not change, so an export cut short ends with an error record instead
+		if writer.records > 0 {
+			message := err.Error()
+			writer.write(swagger.TransferRecord{Type: swagger.TransferRecordTypeError, Error: &message})
+			writer.flush()
+		}
+		return err
+	}
+	return writer.flush()
+}
+
+//exportRecords writes every record of an export to writer
+func (ds *pgDataService) exportRecords(ctx context.Context, writer transferWriter) error {
+	//images are written first so every entry follows the images it links
+	if err := ds.exportImages(ctx, writer); err != nil {
+		return err
+	}
+	if err := ds.exportEntries(ctx, writer); err != nil {
+		return err
+	}
+	summary, err := ds.db.GetSummary(ctx)
+	if err != nil {
+		return logAndReturnError(ctx, err)
+	}
+	if summary.ID != 0 {
+		if err := writer.write(swagger.TransferRecord{Type: swagger.TransferRecordTypeSummary, Summary: &swagger.Summary{Summary: summary.Summary}}); err != nil {
+			return err
+		}
+	}
+	published, err := ds.db.GetPublished(ctx)
+	if err != nil {
+		return logAndReturnError(ctx, err)
+	}
+	if published.ID != 0 {
+		if err := writer.write(swagger.TransferRecord{Type: swagger.TransferRecordTypePublished, Published: &swagger.Published{Published: published.Published}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ds *pgDataService) Import(ctx context.Context, lines []TransferLine, mode string, dryRun bool, actor string) (swagger.ImportResult, error) {
+	defer ds.invalidateCache()
+	records := []maddendb.ImportRecord{}
+	for _, line := range lines {
+		records = append(records, transferToImportRecord(line))
+	}
+	importMode := maddendb.ImportSkip
+	if mode == IMPORT_UPSERT {
+		importMode = maddendb.ImportUpsert
+	}
+	result, err := ds.db.ImportMadden(ctx, records, importMode, dryRun, actor)
+	if err != nil {
+		return swagger.ImportResult{}, logAndReturnError(ctx, err)
+	}
+	converted := swagger.ImportResult{
+		Created: result.Created,
+		Updated: result.Updated,
+		Skipped: result.Skipped,
+		//a failed record rolls the import back, so it was only checked
+		DryRun: dryRun || len(result.Errors) > 0,
+		Errors: []swagger.ImportLineError{},
+	}
+	for _, lineError := range result.Errors {
+		converted.Errors = append(converted.Errors, swagger.ImportLineError{Line: lineError.Line, Message: lineError.Message})
+	}
+	return converted, nil
+}
+
+//DecodeTransfer reads every record of an import in format, records that can not be read are returned as line errors
+//an error is returned if in is not in format at all
+func DecodeTransfer(format string, in io.Reader) ([]TransferLine, []swagger.ImportLineError, error) {
+	switch format {
+	case TRANSFER_NDJSON:
+		return decodeNdjson(in)
+	case TRANSFER_CSV:
+		return decodeCsv(in)
+	}
+	return nil, nil, fmt.Errorf("unknown format %s, supported formats are: %s %s", format, TRANSFER_NDJSON, TRANSFER_CSV)
+}
+
+//SortLineErrors orders line errors by line
+func SortLineErrors(lineErrors []swagger.ImportLineError) {
+	sort.SliceStable(lineErrors, func(i, j int) bool { return lineErrors[i].Line < lineErrors[j].Line })
+}
+
+//exportImages writes every image in creation order
+func (ds *pgDataService) exportImages(ctx context.Context, writer transferWriter) error {
+	var cursor *maddendb.ImageCursor
+	for {
+		images, err := ds.db.GetMaddenImagesAfter(ctx, cursor, EXPORT_PAGE_SIZE)
+		if err != nil {
+			return logAndReturnError(ctx, err)
+		}
+		for _, image := range images {
+			converted := ds.convertImageFile(image)
+			if err := writer.write(swagger.TransferRecord{Type: swagger.TransferRecordTypeImage, Image: &converted}); err != nil {
+				return err
+			}
+		}
+		if len(images) < EXPORT_PAGE_SIZE {
+			return nil
+		}
+		next := maddendb.NewImageCursor(images[len(images)-1])
+		cursor = &next
+	}
+}
+
+//exportEntries writes every entry in id order
+func (ds *pgDataService) exportEntries(ctx context.Context, writer transferWriter) error {
+	afterId := uint(0)
+	for {
+		items, err := ds.db.GetMaddenItemsAfterId(ctx, afterId, EXPORT_PAGE_SIZE)
+		if err != nil {
+			return logAndReturnError(ctx, err)
+		}
+		for _, item := range items {
+			converted := ds.convertSingleModel(item)
+			if err := writer.write(swagger.TransferRecord{Type: swagger.TransferRecordTypeEntry, Entry: &converted}); err != nil {
+				return err
+			}
+		}
+		if len(items) < EXPORT_PAGE_SIZE {
+			return nil
+		}
+		afterId = items[len(items)-1].ID
+	}
+}
+
+func newTransferWriter(format string, out io.Writer) (transferWriter, error) {
+	switch format {
+	case TRANSFER_NDJSON:
+		return &ndjsonWriter{encoder: json.NewEncoder(out)}, nil
+	case TRANSFER_CSV:
+		writer := &csvWriter{writer: csv.NewWriter(out)}
+		return writer, writer.writer.Write(csvColumns)
+	}
+	return nil, fmt.Errorf("unknown format %s, supported formats are: %s %s", format, TRANSFER_NDJSON, TRANSFER_CSV)
+}
+
+//countingWriter counts the records written through a transferWriter
+type countingWriter struct {
+	transferWriter
+	records int
+}
+
+func (writer *countingWriter) write(record swagger.TransferRecord) error {
+	writer.records++
+	return writer.transferWriter.write(record)
+}
+
+//ndjsonWriter writes each record as a line of json
+type ndjsonWriter struct {
+	encoder *json.Encoder
+}
+
+func (writer *ndjsonWriter) write(record swagger.TransferRecord) error {
+	return writer.encoder.Encode(record)
+}
+
+func (writer *ndjsonWriter) flush() error {
+	return nil
+}
+
+//csvWriter writes each record as a row of csvColumns
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (writer *csvWriter) write(record swagger.TransferRecord) error {
+	row := map[string]string{"type": string(record.Type)}
+	switch {
+	case record.Image != nil:
+		image := record.Image
+		row["id"] = strconv.Itoa(image.Id)
+		row["fileName"] = image.FileName
+		row["thumbnail"] = image.Thumbnail
+		if image.ContentHash != nil {
+			row["contentHash"] = *image.ContentHash
+			row["size"] = strconv.FormatInt(*image.Size, 10)
+			row["mimeType"] = *image.MimeType
+			row["width"] = strconv.Itoa(*image.Width)
+			row["height"] = strconv.Itoa(*image.Height)
+		}
+	case record.Entry != nil:
+		entry := record.Entry
+		row["id"] = strconv.Itoa(*entry.Id)
+		row["startDate"] = entry.StartDate
+		row["endDate"] = entry.EndDate
+		row["summary"] = entry.Summary
+		row["details"] = entry.Details
+		row["historical"] = strconv.FormatBool(nullSafeHistoric(entry.Historical))
+		images := []string{}
+		for _, image := range entry.Images {
+			images = append(images, fmt.Sprintf("%d:%s", image.Id, image.Status))
+		}
+		row["images"] = strings.Join(images, CSV_IMAGE_SEPARATOR)
+		if entry.Rrule != nil {
+			row["rrule"] = *entry.Rrule
+		}
+	case record.Summary != nil:
+		row["summary"] = record.Summary.Summary
+	case record.Published != nil:
+		row["published"] = strconv.FormatBool(record.Published.Published)
+	case record.Error != nil:
+		row["details"] = *record.Error
+	}
+	columns := []string{}
+	for _, column := range csvColumns {
+		columns = append(columns, row[column])
+	}
+	return writer.writer.Write(columns)
+}
+
+func (writer *csvWriter) flush() error {
+	writer.writer.Flush()
+	return writer.writer.Error()
+}
+
+//decodeNdjson reads one record per line, blank lines are skipped
+func decodeNdjson(in io.Reader) ([]TransferLine, []swagger.ImportLineError, error) {
+	lines := []TransferLine{}
+	lineErrors := []swagger.ImportLineError{}
+	reader := bufio.NewReader(in)
+	for number := 1; ; number++ {
+		text, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+		if trimmed := bytes.TrimSpace(text); len(trimmed) > 0 {
+			record := swagger.TransferRecord{}
+			if decodeErr := json.Unmarshal(trimmed, &record); decodeErr != nil {
+				lineErrors = append(lineErrors, swagger.ImportLineError{Line: number, Message: fmt.Sprintf("record is not valid json: %s", decodeErr.Error())})
+			} else if shapeErr := transferRecordValid(record); shapeErr != nil {
+				lineErrors = append(lineErrors, swagger.ImportLineError{Line: number, Message: shapeErr.Error()})
+			} else {
+				lines = append(lines, TransferLine{Line: number, Record: record})
+			}
+		}
+		if err == io.EOF {
+			return lines, lineErrors, nil
+		}
+	}
+}
+
+//decodeCsv reads one record per row following a header of csvColumns, the header is line 1
+//lines count records rather than text lines as quoted fields may span several
+func decodeCsv(in io.Reader) ([]TransferLine, []swagger.ImportLineError, error) {
+	reader := csv.NewReader(in)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read csv header: %s", err.Error())
+	}
+	columnNames := csvColumns
+	if strings.Join(header, ",") == strings.Join(csvColumns[:len(csvColumns)-1], ",") {
+		columnNames = csvColumns[:len(csvColumns)-1]
+	} else if strings.Join(header, ",") != strings.Join(csvColumns, ",") {
+		return nil, nil, fmt.Errorf("csv header must be %s", strings.Join(csvColumns, ","))
+	}
+	lines := []TransferLine{}
+	lineErrors := []swagger.ImportLineError{}
+	for number := 2; ; number++ {
+		columns, err := reader.Read()
+		if err == io.EOF {
+			return lines, lineErrors, nil
+		}
+		if parseErr, ok := err.(*csv.ParseError); ok && parseErr.Err == csv.ErrFieldCount {
+			lineErrors = append(lineErrors, swagger.ImportLineError{Line: number, Message: fmt.Sprintf("record must have %d columns", len(columnNames))})
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read csv record %d: %s", number, err.Error())
+		}
+		row := map[string]string{}
+		for i, column := range columnNames {
+			row[column] = columns[i]
+		}
+		record, err := csvRecord(row)
+		if err == nil {
+			err = transferRecordValid(record)
+		}
+		if err != nil {
+			lineErrors = append(lineErrors, swagger.ImportLineError{Line: number, Message: err.Error()})
+			continue
+		}
+		lines = append(lines, TransferLine{Line: number, Record: record})
+	}
+}
+
+//csvRecord converts a row of csvColumns into a record
+func csvRecord(row map[string]string) (swagger.TransferRecord, error) {
+	record := swagger.TransferRecord{Type: swagger.TransferRecordType(row["type"])}
+	var err error
+	switch record.Type {
+	case swagger.TransferRecordTypeImage:
+		image := swagger.ImageFile{FileName: row["fileName"], Thumbnail: row["thumbnail"]}
+		if image.Id, err = strconv.Atoi(row["id"]); err != nil {
+			return record, fmt.Errorf("image id must be a number")
+		}
+		if row["contentHash"] != "" {
+			image.ContentHash = utilities.StrPtr(row["contentHash"])
+			image.MimeType = utilities.StrPtr(row["mimeType"])
+			size, sizeErr := strconv.ParseInt(row["size"], 10, 64)
+			width, widthErr := strconv.Atoi(row["width"])
+			height, heightErr := strconv.Atoi(row["height"])
+			if sizeErr != nil || widthErr != nil || heightErr != nil {
+				return record, fmt.Errorf("image size, width and height must be numbers")
+			}
+			image.Size, image.Width, image.Height = &size, &width, &height
+		}
+		record.Image = &image
+	case swagger.TransferRecordTypeEntry:
+		entry := swagger.MaddenItem{StartDate: row["startDate"], EndDate: row["endDate"], Summary: row["summary"], Details: row["details"], Images: []swagger.MaddenImage{}}
+		if row["rrule"] != "" {
+			entry.Rrule = utilities.StrPtr(row["rrule"])
+		}
+		if row["historical"] != "" {
+			historical, err := strconv.ParseBool(row["historical"])
+			if err != nil {
+				return record, fmt.Errorf("entry historical must be true or false")
+			}
+			entry.Historical = &historical
+		}
+		for _, image := range strings.Split(row["images"], CSV_IMAGE_SEPARATOR) {
+			if image == "" {
+				continue
+			}
+			parts := strings.SplitN(image, ":", 2)
+			id, err := strconv.Atoi(parts[0])
+			if err != nil || len(parts) != 2 {
+				return record, fmt.Errorf("entry images must be written as id:status separated by %s", CSV_IMAGE_SEPARATOR)
+			}
+			entry.Images = append(entry.Images, swagger.MaddenImage{Id: id, Status: swagger.MaddenImageStatus(parts[1])})
+		}
+		record.Entry = &entry
+	case swagger.TransferRecordTypeSummary:
+		record.Summary = &swagger.Summary{Summary: row["summary"]}
+	case swagger.TransferRecordTypePublished:
+		published, err := strconv.ParseBool(row["published"])
+		if err != nil {
+			return record, fmt.Errorf("published must be true or false")
+		}
+		record.Published = &swagger.Published{Published: published}
+	case swagger.TransferRecordTypeError:
+		record.Error = utilities.StrPtr(row["details"])
+	}
+	return record, nil
+}
+
+//transferRecordValid ensures record holds exactly the value its type names
+func transferRecordValid(record swagger.TransferRecord) error {
+	if record.Type == swagger.TransferRecordTypeError && record.Error != nil {
+		return fmt.Errorf("the export ended early and is incomplete: %s", *record.Error)
+	}
+	present := map[swagger.TransferRecordType]bool{
+		swagger.TransferRecordTypeImage:     record.Image != nil,
+		swagger.TransferRecordTypeEntry:     record.Entry != nil,
+		swagger.TransferRecordTypeSummary:   record.Summary != nil,
+		swagger.TransferRecordTypePublished: record.Published != nil,
+		swagger.TransferRecordTypeError:     record.Error != nil,
+	}
+	if _, known := present[record.Type]; !known {
+		return fmt.Errorf("record type must be one of image entry summary published")
+	}
+	for recordType, set := range present {
+		if set != (recordType == record.Type) {
+			return fmt.Errorf("a record of type %s must hold only its %s", record.Type, record.Type)
+		}
+	}
+	return nil
+}
+
+//transferToImportRecord converts a record read from an import, entries keep the image ids of the import
+func transferToImportRecord(line TransferLine) maddendb.ImportRecord {
+	record := maddendb.ImportRecord{Line: line.Line}
+	switch {
+	case line.Record.Image != nil:
+		image := swaggerToImportedImage(*line.Record.Image)
+		record.Image = &image
+	case line.Record.Entry != nil:
+		item := swaggerToEntry(*line.Record.Entry, 0)
+		record.Item = &item
+	case line.Record.Summary != nil:
+		record.Summary = &maddendb.Summary{Summary: line.Record.Summary.Summary}
+	case line.Record.Published != nil:
+		record.Published = &maddendb.Published{Published: line.Record.Published.Published}
+	}
+	return record
+}
+
+//swaggerToImportedImage converts an imported image file including its content details
+func swaggerToImportedImage(image swagger.ImageFile) maddendb.MaddenImageFile {
+	imported := maddendb.MaddenImageFile{
+		Model:     gorm.Model{ID: uint(image.Id)},
+		FileName:  image.FileName,
+		Thumbnail: image.Thumbnail,
+	}
+	if image.ContentHash != nil {
+		imported.ContentHash = *image.ContentHash
+	}
+	if image.Size != nil {
+		imported.Size = *image.Size
+	}
+	if image.MimeType != nil {
+		imported.MimeType = *image.MimeType
+	}
+	if image.Width != nil {
+		imported.Width = *image.Width
+	}
+	if image.Height != nil {
+		imported.Height = *image.Height
+	}
+	return imported
+}
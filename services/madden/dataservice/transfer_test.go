@@ -0,0 +1,81 @@
+package dataservice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+)
+
+//failingSummaryMadden fails reads of the summary, which are made after every image and entry is exported
+type failingSummaryMadden struct {
+	maddendb.Madden
+}
+
+func (db failingSummaryMadden) GetSummary(ctx context.Context) (maddendb.Summary, error) {
+	return maddendb.Summary{}, fmt.Errorf("connection lost")
+}
+
+func TestExportEndedEarly(t *testing.T) {
+	ctx := context.Background()
+	db := maddendb.NewMemoryMadden()
+	if err := db.SetupDatabase(ctx); err != nil {
+		t.Fatalf("unable to setup database ERROR: %s\n", err.Error())
+	}
+	now := time.Now()
+	if _, err := db.CreateMaddenItem(ctx, maddendb.MaddenItem{BeginDate: now.Unix(), EndDate: now.Add(time.Hour).Unix(), Summary: "pump swap"}, false, "tester"); err != nil {
+		t.Fatalf("unable to create item ERROR: %s\n", err.Error())
+	}
+	ds := &pgDataService{db: failingSummaryMadden{Madden: db}}
+	tests := []struct {
+		name   string
+		format string
+	}{
+		{name: "ndjson", format: TRANSFER_NDJSON},
+		{name: "csv", format: TRANSFER_CSV},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out := bytes.Buffer{}
+			if err := ds.Export(ctx, test.format, &out); err == nil {
+				t.Fatalf("expected the export to fail\n")
+			}
+			lines, lineErrors, err := DecodeTransfer(test.format, &out)
+			if err != nil {
+				t.Fatalf("unable to decode export ERROR: %s\n%s\n", err.Error(), out.String())
+			}
+			//the entry is read back and the error record is the last line
+			if len(lines) != 1 || lines[0].Record.Type != swagger.TransferRecordTypeEntry {
+				t.Errorf("expected the entry before the error got %v\n", lines)
+			}
+			if len(lineErrors) != 1 || lineErrors[0].Line != lines[len(lines)-1].Line+1 {
+				t.Fatalf("expected an error on the line after the entry got %v\n", lineErrors)
+			}
+			if !strings.Contains(lineErrors[0].Message, "ended early") {
+				t.Errorf("expected the export to be reported as ended early got %s\n", lineErrors[0].Message)
+			}
+		})
+	}
+}
+
+func TestExportFailedBeforeRecords(t *testing.T) {
+	ctx := context.Background()
+	db := maddendb.NewMemoryMadden()
+	if err := db.SetupDatabase(ctx); err != nil {
+		t.Fatalf("unable to setup database ERROR: %s\n", err.Error())
+	}
+	out := bytes.Buffer{}
+	ds := &pgDataService{db: failingSummaryMadden{Madden: db}}
+	if err := ds.Export(ctx, TRANSFER_NDJSON, &out); err == nil {
+		t.Fatalf("expected the export to fail\n")
+	}
+	//nothing was sent so the error can still be answered with a status
+	if out.Len() != 0 {
+		t.Errorf("expected nothing written got %q\n", out.String())
+	}
+}
@@ -0,0 +1,170 @@
+package dataservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/blobstore"
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/models"
+)
+
+//image upload implementation of MaddenDataService
+
+const (
+	//decoded images larger than this many pixels are refused before their pixels are read
+	MAXIMUM_UPLOAD_PIXELS = 50000000
+	THUMBNAIL_SUFFIX      = "_thumb"
+	JPEG_QUALITY          = 85
+)
+
+var (
+	//the sniffed content types accepted for upload and the extension stored files are given
+	uploadExtensions = map[string]string{
+		"image/png":  ".png",
+		"image/jpeg": ".jpg",
+		"image/gif":  ".gif",
+	}
+)
+
+func (ds *pgDataService) UploadImage(ctx context.Context, content io.Reader) (swagger.ImageFile, bool, error) {
+	defer ds.invalidateCache()
+	if ds.store == nil {
+		return swagger.ImageFile{}, false, models.NewDataServiceError("image uploads are not configured", http.StatusServiceUnavailable)
+	}
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		return swagger.ImageFile{}, false, models.NewDataServiceError(fmt.Sprintf("unable to read uploaded image: %s", err.Error()), http.StatusBadRequest)
+	}
+	contentType := http.DetectContentType(data)
+	extension, accepted := uploadExtensions[contentType]
+	if !accepted {
+		return swagger.ImageFile{}, false, models.NewDataServiceError(fmt.Sprintf("images must be png, jpeg or gif, received %s", contentType), http.StatusUnsupportedMediaType)
+	}
+	decoded, err := decodeUpload(data)
+	if err != nil {
+		return swagger.ImageFile{}, false, err
+	}
+	thumbnail, thumbnailExtension, err := encodeThumbnail(decoded, contentType, ds.thumbnailSize)
+	if err != nil {
+		return swagger.ImageFile{}, false, logAndReturnError(ctx, err)
+	}
+	hash := sha256.Sum256(data)
+	contentHash := hex.EncodeToString(hash[:])
+	bounds := decoded.Bounds()
+	stored := maddendb.MaddenImageFile{
+		FileName:    contentHash + extension,
+		Thumbnail:   contentHash + THUMBNAIL_SUFFIX + thumbnailExtension,
+		ContentHash: contentHash,
+		Size:        int64(len(data)),
+		MimeType:    contentType,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}
+	//blobs are named by content so they are written before the image file that references them, and left in place if it
+	//can not be created as a retry of the same upload reuses them
+	if err := ds.putBlob(ctx, stored.FileName, data); err != nil {
+		return swagger.ImageFile{}, false, err
+	}
+	if err := ds.putBlob(ctx, stored.Thumbnail, thumbnail); err != nil {
+		return swagger.ImageFile{}, false, err
+	}
+	image, created, err := ds.db.FindOrCreateMaddenImage(ctx, stored)
+	if err != nil {
+		return swagger.ImageFile{}, false, logAndReturnError(ctx, err)
+	}
+	return ds.convertImageFile(image), created, nil
+}
+
+//putBlob stores content under name, a blob already stored under the name holds the same content and is kept
+func (ds *pgDataService) putBlob(ctx context.Context, name string, content []byte) error {
+	if err := ds.store.Put(ctx, name, bytes.NewReader(content)); err != nil && !errors.Is(err, blobstore.ErrBlobExists) {
+		return logAndReturnError(ctx, err)
+	}
+	return nil
+}
+
+//decodeUpload decodes uploaded image data, refusing images too large to decode safely
+func decodeUpload(data []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, models.NewDataServiceError(fmt.Sprintf("uploaded image could not be decoded: %s", err.Error()), http.StatusBadRequest)
+	}
+	if config.Width == 0 || config.Height == 0 {
+		return nil, models.NewDataServiceError("uploaded image has no pixels", http.StatusBadRequest)
+	}
+	if config.Width*config.Height > MAXIMUM_UPLOAD_PIXELS {
+		return nil, models.NewDataServiceError(fmt.Sprintf("images must be no more than %d pixels", MAXIMUM_UPLOAD_PIXELS), http.StatusRequestEntityTooLarge)
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, models.NewDataServiceError(fmt.Sprintf("uploaded image could not be decoded: %s", err.Error()), http.StatusBadRequest)
+	}
+	return decoded, nil
+}
+
+//encodeThumbnail returns the encoded thumbnail of source and its extension, jpeg images keep jpeg thumbnails and all others use png
+func encodeThumbnail(source image.Image, contentType string, maxSize int) ([]byte, string, error) {
+	thumbnail := resize(source, maxSize)
+	encoded := bytes.Buffer{}
+	if contentType == "image/jpeg" {
+		if err := jpeg.Encode(&encoded, thumbnail, &jpeg.Options{Quality: JPEG_QUALITY}); err != nil {
+			return nil, "", err
+		}
+		return encoded.Bytes(), ".jpg", nil
+	}
+	if err := png.Encode(&encoded, thumbnail); err != nil {
+		return nil, "", err
+	}
+	return encoded.Bytes(), ".png", nil
+}
+
+//resize scales source down so neither side exceeds maxSize, averaging the source pixels covered by each thumbnail pixel
+//images already within maxSize are copied unscaled
+func resize(source image.Image, maxSize int) image.Image {
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	scaledWidth, scaledHeight := width, height
+	if width > maxSize || height > maxSize {
+		if width >= height {
+			scaledWidth, scaledHeight = maxSize, height*maxSize/width
+		} else {
+			scaledWidth, scaledHeight = width*maxSize/height, maxSize
+		}
+	}
+	if scaledWidth < 1 {
+		scaledWidth = 1
+	}
+	if scaledHeight < 1 {
+		scaledHeight = 1
+	}
+	scaled := image.NewRGBA64(image.Rect(0, 0, scaledWidth, scaledHeight))
+	for y := 0; y < scaledHeight; y++ {
+		top, bottom := bounds.Min.Y+y*height/scaledHeight, bounds.Min.Y+(y+1)*height/scaledHeight
+		for x := 0; x < scaledWidth; x++ {
+			left, right := bounds.Min.X+x*width/scaledWidth, bounds.Min.X+(x+1)*width/scaledWidth
+			var r, g, b, a, count uint64
+			for sourceY := top; sourceY < bottom; sourceY++ {
+				for sourceX := left; sourceX < right; sourceX++ {
+					pr, pg, pb, pa := source.At(sourceX, sourceY).RGBA()
+					r, g, b, a, count = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa), count+1
+				}
+			}
+			scaled.Set(x, y, color.RGBA64{R: uint16(r / count), G: uint16(g / count), B: uint16(b / count), A: uint16(a / count)})
+		}
+	}
+	return scaled
+}
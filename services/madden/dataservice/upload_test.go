@@ -0,0 +1,116 @@
+package dataservice
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"testing"
+
+	"github.com/PurplWarrior22/TestingCode/services/models"
+)
+
+//encodePng returns a png of a width by height image filled with fill
+func encodePng(t *testing.T, width, height int, fill color.Color) []byte {
+	source := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			source.Set(x, y, fill)
+		}
+	}
+	encoded := bytes.Buffer{}
+	if err := png.Encode(&encoded, source); err != nil {
+		t.Fatalf("unable to encode test png ERROR: %s\n", err.Error())
+	}
+	return encoded.Bytes()
+}
+
+//withPngSize rewrites the size declared by the header of a png, leaving its pixel data as it was
+func withPngSize(data []byte, width, height uint32) []byte {
+	patched := append([]byte{}, data...)
+	//the IHDR chunk follows the 8 byte signature, its length and type take 8 bytes before the width and height
+	binary.BigEndian.PutUint32(patched[16:20], width)
+	binary.BigEndian.PutUint32(patched[20:24], height)
+	//the chunk crc covers its type and data
+	binary.BigEndian.PutUint32(patched[29:33], crc32.ChecksumIEEE(patched[12:29]))
+	return patched
+}
+
+func TestResize(t *testing.T) {
+	fill := color.RGBA{R: 200, G: 100, B: 50, A: 255}
+	tests := []struct {
+		name           string
+		width, height  int
+		maxSize        int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "under the thumbnail size", width: 40, height: 30, maxSize: 200, expectedWidth: 40, expectedHeight: 30},
+		{name: "at the thumbnail size", width: 200, height: 200, maxSize: 200, expectedWidth: 200, expectedHeight: 200},
+		{name: "wide", width: 400, height: 100, maxSize: 200, expectedWidth: 200, expectedHeight: 50},
+		{name: "tall", width: 100, height: 400, maxSize: 200, expectedWidth: 50, expectedHeight: 200},
+		{name: "one pixel high", width: 1000, height: 1, maxSize: 200, expectedWidth: 200, expectedHeight: 1},
+		{name: "one pixel wide", width: 1, height: 1000, maxSize: 200, expectedWidth: 1, expectedHeight: 200},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source := image.NewRGBA(image.Rect(0, 0, test.width, test.height))
+			for y := 0; y < test.height; y++ {
+				for x := 0; x < test.width; x++ {
+					source.Set(x, y, fill)
+				}
+			}
+			scaled := resize(source, test.maxSize)
+			bounds := scaled.Bounds()
+			if bounds.Dx() != test.expectedWidth || bounds.Dy() != test.expectedHeight {
+				t.Errorf("expected %dx%d thumbnail got %dx%d\n", test.expectedWidth, test.expectedHeight, bounds.Dx(), bounds.Dy())
+			}
+			//averaging pixels of a single color keeps the color
+			for _, corner := range []image.Point{bounds.Min, bounds.Max.Sub(image.Point{X: 1, Y: 1})} {
+				if got := color.RGBAModel.Convert(scaled.At(corner.X, corner.Y)); got != fill {
+					t.Errorf("expected pixel %v to be %v got %v\n", corner, fill, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeUpload(t *testing.T) {
+	valid := encodePng(t, 4, 3, color.White)
+	tests := []struct {
+		name         string
+		data         []byte
+		expectedCode int
+	}{
+		{name: "valid", data: valid},
+		{name: "one pixel wide", data: encodePng(t, 1, 500, color.Black)},
+		{name: "not an image", data: []byte("not an image"), expectedCode: http.StatusBadRequest},
+		{name: "no pixels", data: withPngSize(valid, 0, 3), expectedCode: http.StatusBadRequest},
+		//only the header is read before the image is refused, so the pixel data need not match it
+		{name: "declares too many pixels", data: withPngSize(valid, 10000, MAXIMUM_UPLOAD_PIXELS/10000+1), expectedCode: http.StatusRequestEntityTooLarge},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			decoded, err := decodeUpload(test.data)
+			if test.expectedCode == 0 {
+				if err != nil {
+					t.Fatalf("expected upload to decode got ERROR: %s\n", err.Error())
+				}
+				if decoded.Bounds().Empty() {
+					t.Errorf("expected a decoded image with pixels\n")
+				}
+				return
+			}
+			converted, ok := err.(models.DataServiceError)
+			if !ok {
+				t.Fatalf("expected a DataServiceError with code %d got %v\n", test.expectedCode, err)
+			}
+			if converted.ErrorCode() != test.expectedCode {
+				t.Errorf("expected code %d got %d: %s\n", test.expectedCode, converted.ErrorCode(), converted.Error())
+			}
+		})
+	}
+}
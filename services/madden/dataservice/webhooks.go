@@ -0,0 +1,147 @@
+package dataservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/PurplWarrior22/TestingCode/services/madden/swagger"
+	"github.com/PurplWarrior22/TestingCode/services/maddendb"
+	"github.com/PurplWarrior22/TestingCode/services/utilities"
+)
+
+//webhook subscription implementation of MaddenDataService
+
+const (
+	//random bytes of a generated webhook secret, it is hex encoded
+	WEBHOOK_SECRET_BYTES = 32
+)
+
+func (ds *pgDataService) CreateWebhook(ctx context.Context, webhook swagger.Webhook) (swagger.Webhook, error) {
+	subscription := maddendb.WebhookSubscription{Url: webhook.Url}
+	if webhook.Secret != nil && *webhook.Secret != "" {
+		subscription.Secret = *webhook.Secret
+	} else {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return swagger.Webhook{}, logAndReturnError(ctx, err)
+		}
+		subscription.Secret = secret
+	}
+	events := []string{}
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+	subscription.SetEvents(events)
+	created, err := ds.db.CreateWebhook(ctx, subscription)
+	if err != nil {
+		return swagger.Webhook{}, logAndReturnError(ctx, err)
+	}
+	converted := convertWebhook(created)
+	//the secret is only ever returned here
+	converted.Secret = utilities.StrPtr(created.Secret)
+	return converted, nil
+}
+
+func (ds *pgDataService) GetWebhooks(ctx context.Context) (swagger.Webhooks, error) {
+	subscriptions, err := ds.db.GetWebhooks(ctx)
+	if err != nil {
+		return swagger.Webhooks{}, logAndReturnError(ctx, err)
+	}
+	webhooks := swagger.Webhooks{Webhooks: []swagger.Webhook{}}
+	for _, subscription := range subscriptions {
+		webhooks.Webhooks = append(webhooks.Webhooks, convertWebhook(subscription))
+	}
+	return webhooks, nil
+}
+
+func (ds *pgDataService) GetWebhookById(ctx context.Context, id int) (swagger.Webhook, error) {
+	subscription, err := ds.db.GetWebhookById(ctx, uint(id))
+	if err != nil {
+		return swagger.Webhook{}, logAndReturnError(ctx, err)
+	}
+	return convertWebhook(subscription), nil
+}
+
+func (ds *pgDataService) DeleteWebhook(ctx context.Context, id int) error {
+	if err := ds.db.DeleteWebhook(ctx, uint(id)); err != nil {
+		return logAndReturnError(ctx, err)
+	}
+	return nil
+}
+
+func (ds *pgDataService) GetWebhookDeliveries(ctx context.Context, id int, status string, pageNumber, pageSize int) (swagger.WebhookDeliveries, error) {
+	if _, err := ds.db.GetWebhookById(ctx, uint(id)); err != nil {
+		return swagger.WebhookDeliveries{}, logAndReturnError(ctx, err)
+	}
+	deliveries, err := ds.db.GetWebhookDeliveries(ctx, uint(id), status, pageNumber, pageSize)
+	if err != nil {
+		return swagger.WebhookDeliveries{}, logAndReturnError(ctx, err)
+	}
+	page := swagger.WebhookDeliveries{Deliveries: []swagger.WebhookDelivery{}}
+	for _, delivery := range deliveries {
+		page.Deliveries = append(page.Deliveries, convertWebhookDelivery(delivery))
+	}
+	return page, nil
+}
+
+func (ds *pgDataService) RedeliverWebhookDelivery(ctx context.Context, id, deliveryId int) (swagger.WebhookDelivery, error) {
+	if _, err := ds.db.GetWebhookById(ctx, uint(id)); err != nil {
+		return swagger.WebhookDelivery{}, logAndReturnError(ctx, err)
+	}
+	delivery, err := ds.db.RedeliverWebhookDelivery(ctx, uint(id), uint(deliveryId))
+	if err != nil {
+		return swagger.WebhookDelivery{}, logAndReturnError(ctx, err)
+	}
+	return convertWebhookDelivery(delivery), nil
+}
+
+//convertWebhook converts subscription leaving out its secret
+func convertWebhook(subscription maddendb.WebhookSubscription) swagger.Webhook {
+	webhook := swagger.Webhook{
+		Id:        uintPtr(int(subscription.ID)),
+		Url:       subscription.Url,
+		CreatedAt: utilities.StrPtr(subscription.CreatedAt.UTC().Format(time.RFC3339)),
+		Events:    []swagger.WebhookEvent{},
+	}
+	for _, event := range subscription.GetEvents() {
+		webhook.Events = append(webhook.Events, swagger.WebhookEvent(event))
+	}
+	return webhook
+}
+
+func convertWebhookDelivery(delivery maddendb.WebhookDelivery) swagger.WebhookDelivery {
+	converted := swagger.WebhookDelivery{
+		Id:        int(delivery.ID),
+		WebhookId: int(delivery.WebhookSubscriptionId),
+		Event:     swagger.WebhookEvent(delivery.Event),
+		Status:    swagger.WebhookDeliveryStatus(delivery.Status),
+		Attempts:  int(delivery.Attempts),
+		CreatedAt: delivery.CreatedAt.UTC().Format(time.RFC3339),
+		Payload:   map[string]interface{}{},
+	}
+	//payloads are written by this service, one that does not decode is listed empty rather than hiding the delivery
+	if err := json.Unmarshal([]byte(delivery.Payload), &converted.Payload); err != nil {
+		converted.Payload = map[string]interface{}{}
+	}
+	if delivery.LastError != "" {
+		converted.LastError = utilities.StrPtr(delivery.LastError)
+	}
+	if delivery.Status == maddendb.DELIVERY_PENDING {
+		converted.NextAttemptAt = utilities.StrPtr(delivery.NextAttemptAt.UTC().Format(time.RFC3339))
+	}
+	if delivery.DeliveredAt != nil {
+		converted.DeliveredAt = utilities.StrPtr(delivery.DeliveredAt.UTC().Format(time.RFC3339))
+	}
+	return converted
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, WEBHOOK_SECRET_BYTES)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"../services/maddendb"
+	"../services/utilities"
+)
+
+//the change event purge job, deletes change events older than the retention period, clients resuming from a purged event miss the events between
+
+const (
+	EVENT_RETENTION_ENV     = "EVENT_RETENTION"
+	EVENT_RETENTION_DEFAULT = "24h"
+	EVENT_PURGE_INTERVAL    = time.Hour
+)
+
+//changeEventPurger periodically purges change events older than retention
+type changeEventPurger struct {
+	db        maddendb.Madden
+	retention time.Duration
+}
+
+//newChangeEventPurgerFromEnvironment builds a purger from the environment, returning nil if purging is disabled by a retention of 0
+func newChangeEventPurgerFromEnvironment(db maddendb.Madden) (*changeEventPurger, error) {
+	retention, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(EVENT_RETENTION_ENV, EVENT_RETENTION_DEFAULT))
+	if err != nil || retention < 0 {
+		return nil, fmt.Errorf("%s must be a non negative duration such as 24h", EVENT_RETENTION_ENV)
+	}
+	if retention == 0 {
+		return nil, nil
+	}
+	return &changeEventPurger{db: db, retention: retention}, nil
+}
+
+//run purges once immediately then once every EVENT_PURGE_INTERVAL, it never returns
+func (purger *changeEventPurger) run() {
+	ticker := time.NewTicker(EVENT_PURGE_INTERVAL)
+	defer ticker.Stop()
+	for {
+		purger.purge()
+		<-ticker.C
+	}
+}
+
+//purge deletes change events older than the retention period, logging the outcome
+func (purger *changeEventPurger) purge() {
+	cutoff := time.Now().Add(-purger.retention)
+	ctx, cancel := context.WithTimeout(context.Background(), EVENT_PURGE_INTERVAL)
+	defer cancel()
+	purged, err := purger.db.PurgeChangeEvents(ctx, cutoff)
+	if err != nil {
+		fmt.Printf("change event purge failed ERROR: %s\n", err.Error())
+		return
+	}
+	fmt.Printf("change event purge removed %d events created before %s\n", purged, cutoff.UTC().Format(time.RFC3339))
+}
@@ -1,4 +1,4 @@
 #!/bin/bash
 #generates the server boilerplate 
 
-oapi-codegen -package swagger ../../api-docs/madden-swagger.yaml > swagger/madden.gen.go
\ No newline at end of file
+oapi-codegen -package swagger ../../api-docs/madden-swagger.yaml > swagger/maintenance.gen.go
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"../services/maddendb"
+	"../services/utilities"
+)
+
+//the historicize job, marks entries historical once they have ended and the grace period has passed
+//every instance schedules the job, the data store lets one instance run at a time and the others skip that run
+
+const (
+	HISTORICIZE_INTERVAL_ENV     = "HISTORICIZE_INTERVAL"
+	HISTORICIZE_GRACE_ENV        = "HISTORICIZE_GRACE_PERIOD"
+	HISTORICIZE_INTERVAL_DEFAULT = "5m"
+	HISTORICIZE_GRACE_DEFAULT    = "24h"
+	//revisions made by the job are attributed to this actor
+	HISTORICIZE_ACTOR = "historicize"
+)
+
+//historicizer periodically marks entries that ended more than grace ago historical
+type historicizer struct {
+	db       maddendb.Madden
+	grace    time.Duration
+	interval time.Duration
+}
+
+//newHistoricizerFromEnvironment builds a historicizer from the environment, returning nil if it is disabled by an interval of 0
+func newHistoricizerFromEnvironment(db maddendb.Madden) (*historicizer, error) {
+	interval, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(HISTORICIZE_INTERVAL_ENV, HISTORICIZE_INTERVAL_DEFAULT))
+	if err != nil || interval < 0 {
+		return nil, fmt.Errorf("%s must be a non negative duration such as 5m", HISTORICIZE_INTERVAL_ENV)
+	}
+	grace, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(HISTORICIZE_GRACE_ENV, HISTORICIZE_GRACE_DEFAULT))
+	if err != nil || grace < 0 {
+		return nil, fmt.Errorf("%s must be a non negative duration such as 24h", HISTORICIZE_GRACE_ENV)
+	}
+	if interval == 0 {
+		return nil, nil
+	}
+	return &historicizer{db: db, grace: grace, interval: interval}, nil
+}
+
+//run historicizes once immediately then once every interval, it never returns
+func (job *historicizer) run() {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+	for {
+		job.historicize()
+		<-ticker.C
+	}
+}
+
+//historicize marks entries that ended before the grace period historical, logging the outcome
+func (job *historicizer) historicize() {
+	cutoff := time.Now().Add(-job.grace)
+	//bounded by the interval so a stuck run never overlaps the next
+	ctx, cancel := context.WithTimeout(context.Background(), job.interval)
+	defer cancel()
+	run, ran, err := job.db.HistoricizeMaddenItems(ctx, cutoff, HISTORICIZE_ACTOR)
+	if err != nil {
+		fmt.Printf("historicize failed ERROR: %s\n", err.Error())
+		return
+	}
+	if !ran {
+		fmt.Println("historicize skipped, another instance is running it")
+		return
+	}
+	if run.Changed > 0 {
+		fmt.Printf("historicize marked %d entries ended before %s historical\n", run.Changed, cutoff.UTC().Format(time.RFC3339))
+	}
+}
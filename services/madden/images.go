@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"../services/madden/blobstore"
+	"../services/maddendb"
+)
+
+//the images command, run as "madden images check|repair" to find and remove stored images and item images nothing refers to
+
+const (
+	IMAGES_COMMAND = "images"
+	IMAGES_CHECK   = "check"
+	IMAGES_REPAIR  = "repair"
+	IMAGES_USAGE   = "usage: madden images check|repair"
+	//blobs are written before the image file referring to them, newer blobs may belong to an upload still in progress
+	ORPHAN_GRACE_PERIOD = time.Hour
+	REPAIR_ACTOR        = "maintenance"
+)
+
+//imageProblems holds everything found by an images check
+type imageProblems struct {
+	orphanedBlobs []string
+	missingBlobs  []string
+	danglingLinks []maddendb.ItemImages
+}
+
+func (problems imageProblems) empty() bool {
+	return len(problems.orphanedBlobs) == 0 && len(problems.missingBlobs) == 0 && len(problems.danglingLinks) == 0
+}
+
+//runImages runs the images sub command with args returning the exit code for the process
+func runImages(args []string) int {
+	if len(args) != 1 || (args[0] != IMAGES_CHECK && args[0] != IMAGES_REPAIR) {
+		fmt.Println(IMAGES_USAGE)
+		return 2
+	}
+	db, err := buildDatabase()
+	if err != nil {
+		fmt.Printf("unable to build database connection due to ERROR: %s\n", err.Error())
+		return 1
+	}
+	ctx := context.Background()
+	if err := db.SetupDatabase(ctx); err != nil {
+		return imagesExitCode(err)
+	}
+	store, err := buildBlobStore()
+	if err != nil {
+		fmt.Println(err.Error())
+		return 1
+	}
+	problems, err := findImageProblems(ctx, db, store, time.Now())
+	if err != nil {
+		return imagesExitCode(err)
+	}
+	printImageProblems(problems)
+	if args[0] == IMAGES_CHECK {
+		if problems.empty() {
+			return 0
+		}
+		return 1
+	}
+	for _, name := range problems.orphanedBlobs {
+		if err := store.Delete(ctx, name); err != nil {
+			return imagesExitCode(err)
+		}
+		fmt.Printf("deleted orphaned blob %s\n", name)
+	}
+	removed, err := db.RemoveDanglingItemImages(ctx, REPAIR_ACTOR)
+	if err != nil {
+		return imagesExitCode(err)
+	}
+	for _, link := range removed {
+		fmt.Printf("removed dangling item image %d\n", link.ID)
+	}
+	if len(problems.missingBlobs) > 0 {
+		fmt.Println("missing blobs can not be repaired, upload the images again or delete them")
+	}
+	return 0
+}
+
+//findImageProblems finds blobs no image refers to, blobs images refer to that are not stored and dangling item images
+//images in the trash still refer to their blobs as they may be restored
+func findImageProblems(ctx context.Context, db maddendb.Madden, store blobstore.BlobStore, now time.Time) (imageProblems, error) {
+	problems := imageProblems{}
+	images, err := db.GetAllMaddenImages(ctx)
+	if err != nil {
+		return problems, err
+	}
+	blobs, err := store.List(ctx)
+	if err != nil {
+		return problems, err
+	}
+	stored := map[string]bool{}
+	for _, blob := range blobs {
+		stored[blob.Name] = true
+	}
+	referenced := map[string]bool{}
+	for _, image := range images {
+		for _, name := range []string{image.FileName, image.Thumbnail} {
+			if referenced[name] {
+				continue
+			}
+			referenced[name] = true
+			//only uploaded images are known to be kept in the store
+			if image.ContentHash != "" && !stored[name] {
+				problems.missingBlobs = append(problems.missingBlobs, name)
+			}
+		}
+	}
+	for _, blob := range blobs {
+		if !referenced[blob.Name] && now.Sub(blob.ModifiedAt) > ORPHAN_GRACE_PERIOD {
+			problems.orphanedBlobs = append(problems.orphanedBlobs, blob.Name)
+		}
+	}
+	problems.danglingLinks, err = db.GetDanglingItemImages(ctx)
+	return problems, err
+}
+
+//printImageProblems logs each problem found
+func printImageProblems(problems imageProblems) {
+	for _, name := range problems.orphanedBlobs {
+		fmt.Printf("orphaned blob %s\n", name)
+	}
+	for _, name := range problems.missingBlobs {
+		fmt.Printf("missing blob %s\n", name)
+	}
+	for _, link := range problems.danglingLinks {
+		fmt.Printf("dangling item image %d linking item %d to image %d\n", link.ID, link.MaddenItemId, link.MaddenImageFileId)
+	}
+	if problems.empty() {
+		fmt.Println("no image problems found")
+	}
+}
+
+//imagesExitCode logs err and returns the exit code for a failed images command
+func imagesExitCode(err error) int {
+	fmt.Printf("images command failed ERROR: %s\n", err.Error())
+	if converted, ok := err.(*maddendb.DbError); ok && converted.OriginalError != nil {
+		fmt.Println(converted.OriginalError.Error())
+	}
+	return 1
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 
+	"../services/madden/blobstore"
 	"../services/madden/controller"
 	"../services/madden/dataservice"
 	"../services/madden/swagger"
@@ -11,12 +12,46 @@ import (
 const (
 	IMAGE_BASE_PATH_ENV = "IMAGE_PATH"
 	SERVER_PORT_ENV     = "SERVER_PORT"
+	DATA_STORE_ENV      = "DATA_STORE"
+	POSTGRES_STORE      = "postgres"
+	MEMORY_STORE        = "memory"
+	//request deadlines per route class, reads are GET and HEAD requests
+	READ_TIMEOUT_ENV      = "READ_TIMEOUT"
+	WRITE_TIMEOUT_ENV     = "WRITE_TIMEOUT"
+	READ_TIMEOUT_DEFAULT  = "10s"
+	WRITE_TIMEOUT_DEFAULT = "30s"
+	//how long startup waits for the data store to confirm its schema
+	SETUP_TIMEOUT = 30 * time.Second
+	//uploaded images are stored by the blob store selected by IMAGE_STORE, the store must be served at IMAGE_PATH
+	IMAGE_STORE_ENV                = "IMAGE_STORE"
+	IMAGE_STORE_DIR_ENV            = "IMAGE_STORE_DIR"
+	IMAGE_UPLOAD_MAX_BYTES_ENV     = "IMAGE_UPLOAD_MAX_BYTES"
+	THUMBNAIL_SIZE_ENV             = "THUMBNAIL_SIZE"
+	LOCAL_IMAGE_STORE              = "local"
+	IMAGE_STORE_DIR_DEFAULT        = "images"
+	IMAGE_UPLOAD_MAX_BYTES_DEFAULT = "10485760"
+	IMPORT_MAX_BYTES_ENV           = "IMPORT_MAX_BYTES"
+	IMPORT_MAX_BYTES_DEFAULT       = "67108864"
+	THUMBNAIL_SIZE_DEFAULT         = "200"
+	//with strict conflicts entries overlapping other entries on a shared image are refused rather than written with warnings
+	STRICT_CONFLICTS_ENV     = "STRICT_CONFLICTS"
+	STRICT_CONFLICTS_DEFAULT = "false"
+	//entry lists, the summary and the published state are cached until a write or for at most CACHE_TTL, a CACHE_SIZE of 0 disables the cache
+	CACHE_SIZE_ENV     = "CACHE_SIZE"
+	CACHE_TTL_ENV      = "CACHE_TTL"
+	CACHE_SIZE_DEFAULT = "256"
+	CACHE_TTL_DEFAULT  = "30s"
 )
 
 var (
 	pathBuilder utilities.PathBuilder
 	maintData   dataservice.MaddenDataService
+	maddenDb    maddendb.Madden
 	serverPort  = "8080"
+	//the largest image accepted by the upload endpoint in bytes
+	maxUploadBytes int64
+	//the largest body accepted by the import endpoint in bytes
+	maxImportBytes int64
 )
 
 func init() {
@@ -26,27 +61,182 @@ func init() {
 	}
 	serverPort = utilities.GetEnvDefaultAndLog(SERVER_PORT_ENV, serverPort)
 	pathBuilder = utilities.NewSimpleAppender(imageBase)
-	db, err := maddendb.BuildPostgresMaddenFromEnvironment()
+}
+
+//setupDataService connects to the data store, refusing to continue if its schema does not match this binary
+func setupDataService() {
+	db, err := buildDatabase()
 	if err != nil {
 		fmt.Printf("unable to build pg database connection due to ERROR: %s\n", err.Error())
 		os.Exit(1)
 	}
-	if err := db.SetupDatabase(); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), SETUP_TIMEOUT)
+	defer cancel()
+	if err := db.SetupDatabase(ctx); err != nil {
 		fmt.Printf("Error while building database connection")
 		convertedErr, _ := err.(*maddendb.DbError)
 		fmt.Println(err.Error())
 		fmt.Println(convertedErr.OriginalError.Error())
 		os.Exit(1)
 	}
-	maddenData = dataservice.NewPgDataService(db, pathBuilder)
+	maddenDb = db
+	store, err := buildBlobStore()
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	maxUploadBytes, err = strconv.ParseInt(utilities.GetEnvDefaultAndLog(IMAGE_UPLOAD_MAX_BYTES_ENV, IMAGE_UPLOAD_MAX_BYTES_DEFAULT), 10, 64)
+	if err != nil || maxUploadBytes < 1 {
+		fmt.Printf("%s must be a positive number of bytes\n", IMAGE_UPLOAD_MAX_BYTES_ENV)
+		os.Exit(1)
+	}
+	maxImportBytes, err = strconv.ParseInt(utilities.GetEnvDefaultAndLog(IMPORT_MAX_BYTES_ENV, IMPORT_MAX_BYTES_DEFAULT), 10, 64)
+	if err != nil || maxImportBytes < 1 {
+		fmt.Printf("%s must be a positive number of bytes\n", IMPORT_MAX_BYTES_ENV)
+		os.Exit(1)
+	}
+	thumbnailSize, err := strconv.Atoi(utilities.GetEnvDefaultAndLog(THUMBNAIL_SIZE_ENV, THUMBNAIL_SIZE_DEFAULT))
+	if err != nil || thumbnailSize < 1 {
+		fmt.Printf("%s must be a positive number of pixels\n", THUMBNAIL_SIZE_ENV)
+		os.Exit(1)
+	}
+	maddenData = dataservice.NewPgDataService(db, pathBuilder, store, thumbnailSize, responseCacheFromEnvironment())
+}
+
+//responseCacheFromEnvironment builds the response cache from the environment, returning nil if caching is disabled
+func responseCacheFromEnvironment() dataservice.ResponseCache {
+	size, err := strconv.Atoi(utilities.GetEnvDefaultAndLog(CACHE_SIZE_ENV, CACHE_SIZE_DEFAULT))
+	if err != nil || size < 0 {
+		fmt.Printf("%s must be a non negative number of responses\n", CACHE_SIZE_ENV)
+		os.Exit(1)
+	}
+	ttl, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(CACHE_TTL_ENV, CACHE_TTL_DEFAULT))
+	if err != nil || ttl <= 0 {
+		fmt.Printf("%s must be a positive duration such as 30s\n", CACHE_TTL_ENV)
+		os.Exit(1)
+	}
+	if size == 0 {
+		fmt.Println("response cache disabled")
+		return nil
+	}
+	return dataservice.NewLRUCache(size, ttl)
+}
+
+//buildBlobStore builds the store for uploaded images selected by the environment, defaulting to a local directory
+func buildBlobStore() (blobstore.BlobStore, error) {
+	if kind := utilities.GetEnvDefaultAndLog(IMAGE_STORE_ENV, LOCAL_IMAGE_STORE); kind != LOCAL_IMAGE_STORE {
+		return nil, fmt.Errorf("unknown %s %s, supported stores are: %s", IMAGE_STORE_ENV, kind, LOCAL_IMAGE_STORE)
+	}
+	return blobstore.NewLocalBlobStore(utilities.GetEnvDefaultAndLog(IMAGE_STORE_DIR_ENV, IMAGE_STORE_DIR_DEFAULT))
+}
+
+//startTrashPurge starts the trash purge job in the background unless it is disabled
+func startTrashPurge() {
+	purger, err := newTrashPurgerFromEnvironment(maddenDb)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	if purger == nil {
+		fmt.Println("trash purge disabled")
+		return
+	}
+	go purger.run()
+}
+
+//startWebhookDispatch starts the webhook dispatcher in the background unless it is disabled
+func startWebhookDispatch() {
+	dispatcher, err := newWebhookDispatcherFromEnvironment(maddenDb)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	if dispatcher == nil {
+		fmt.Println("webhook dispatch disabled")
+		return
+	}
+	go dispatcher.run()
+}
+
+//startChangeEventPurge starts the change event purge job in the background unless it is disabled
+func startChangeEventPurge() {
+	purger, err := newChangeEventPurgerFromEnvironment(maddenDb)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	if purger == nil {
+		fmt.Println("change event purge disabled")
+		return
+	}
+	go purger.run()
+}
+
+//startHistoricize starts the historicize job in the background unless it is disabled
+func startHistoricize() {
+	job, err := newHistoricizerFromEnvironment(maddenDb)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	if job == nil {
+		fmt.Println("historicize disabled")
+		return
+	}
+	go job.run()
+}
+
+//requestDeadlinesFromEnvironment builds the request deadline middleware from the environment, a timeout of 0 disables that deadline
+func requestDeadlinesFromEnvironment() echo.MiddlewareFunc {
+	read, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(READ_TIMEOUT_ENV, READ_TIMEOUT_DEFAULT))
+	if err != nil || read < 0 {
+		fmt.Printf("%s must be a non negative duration such as 10s\n", READ_TIMEOUT_ENV)
+		os.Exit(1)
+	}
+	write, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(WRITE_TIMEOUT_ENV, WRITE_TIMEOUT_DEFAULT))
+	if err != nil || write < 0 {
+		fmt.Printf("%s must be a non negative duration such as 30s\n", WRITE_TIMEOUT_ENV)
+		os.Exit(1)
+	}
+	return controller.RequestDeadlines(read, write)
+}
+
+//strictConflictsFromEnvironment returns true if entries conflicting with others are to be refused
+func strictConflictsFromEnvironment() bool {
+	strict, err := strconv.ParseBool(utilities.GetEnvDefaultAndLog(STRICT_CONFLICTS_ENV, STRICT_CONFLICTS_DEFAULT))
+	if err != nil {
+		fmt.Printf("%s must be true or false\n", STRICT_CONFLICTS_ENV)
+		os.Exit(1)
+	}
+	return strict
+}
+
+//buildDatabase builds the madden data store selected by the environment, defaulting to postgres
+func buildDatabase() (maddendb.Madden, error) {
+	if utilities.GetEnvDefaultAndLog(DATA_STORE_ENV, POSTGRES_STORE) == MEMORY_STORE {
+		return maddendb.NewMemoryMadden(), nil
+	}
+	return maddendb.BuildPostgresMaddenFromEnvironment()
 }
 
 //build and run the madden db server
 func main() {
-	handler := controller.NewMaddenServerHandler(maddenData)
+	if len(os.Args) > 1 && os.Args[1] == MIGRATE_COMMAND {
+		os.Exit(runMigrate(os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == IMAGES_COMMAND {
+		os.Exit(runImages(os.Args[2:]))
+	}
+	setupDataService()
+	startTrashPurge()
+	startWebhookDispatch()
+	startChangeEventPurge()
+	startHistoricize()
+	handler := controller.NewMaddenServerHandler(maddenData, maxUploadBytes, maxImportBytes, strictConflictsFromEnvironment())
 	e := echo.New()
 	echopprof.Wrap(e)
 	e.Use(middleware.Logger())
+	e.Use(requestDeadlinesFromEnvironment())
 	swagger.RegisterHandlers(e, handler)
 	log.Fatal(e.Start(fmt.Sprintf(":%s", serverPort)))
 }
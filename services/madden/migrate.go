@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"../services/maddendb"
+)
+
+//the migrate command, run as "madden migrate up|down [steps]|status" against the database configured in the environment
+
+const (
+	MIGRATE_COMMAND    = "migrate"
+	MIGRATE_UP         = "up"
+	MIGRATE_DOWN       = "down"
+	MIGRATE_STATUS     = "status"
+	MIGRATE_USAGE      = "usage: madden migrate up|down [steps]|status"
+	DEFAULT_DOWN_STEPS = 1
+)
+
+//runMigrate runs the migrate sub command with args returning the exit code for the process
+func runMigrate(args []string) int {
+	if len(args) < 1 {
+		fmt.Println(MIGRATE_USAGE)
+		return 2
+	}
+	migrator, err := maddendb.BuildPostgresMigratorFromEnvironment()
+	if err != nil {
+		fmt.Printf("unable to build migrator due to ERROR: %s\n", err.Error())
+		return 1
+	}
+	switch args[0] {
+	case MIGRATE_UP:
+		applied, err := migrator.MigrateUp()
+		printMigrations("applied", applied)
+		return migrateExitCode(err)
+	case MIGRATE_DOWN:
+		steps := DEFAULT_DOWN_STEPS
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				fmt.Println(MIGRATE_USAGE)
+				return 2
+			}
+		}
+		rolledBack, err := migrator.MigrateDown(steps)
+		printMigrations("rolled back", rolledBack)
+		return migrateExitCode(err)
+	case MIGRATE_STATUS:
+		states, err := migrator.MigrationStatus()
+		if err != nil {
+			return migrateExitCode(err)
+		}
+		for _, state := range states {
+			status := "pending"
+			if state.AppliedAt != nil {
+				status = fmt.Sprintf("applied %s", state.AppliedAt.Format(time.RFC3339))
+			}
+			if state.Unknown {
+				status += " (unknown to this binary)"
+			}
+			fmt.Printf("%04d_%s\t%s\n", state.Version, state.Name, status)
+		}
+		return migrateExitCode(migrator.CheckSchemaVersion())
+	default:
+		fmt.Println(MIGRATE_USAGE)
+		return 2
+	}
+}
+
+//printMigrations logs each migration acted on
+func printMigrations(action string, migrations []maddendb.Migration) {
+	for _, migration := range migrations {
+		fmt.Printf("%s %04d_%s\n", action, migration.Version, migration.Name)
+	}
+	if len(migrations) == 0 {
+		fmt.Printf("no migrations %s\n", action)
+	}
+}
+
+//migrateExitCode logs err if present and returns the matching exit code
+func migrateExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	fmt.Printf("migration failed ERROR: %s\n", err.Error())
+	if converted, ok := err.(*maddendb.DbError); ok && converted.OriginalError != nil {
+		fmt.Println(converted.OriginalError.Error())
+	}
+	return 1
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"../services/maddendb"
+	"../services/utilities"
+)
+
+//the trash purge job, hard deletes madden items and images that have been deleted for longer than the retention period
+
+const (
+	TRASH_RETENTION_DAYS_ENV     = "TRASH_RETENTION_DAYS"
+	TRASH_PURGE_INTERVAL_ENV     = "TRASH_PURGE_INTERVAL"
+	TRASH_RETENTION_DAYS_DEFAULT = "30"
+	TRASH_PURGE_INTERVAL_DEFAULT = "1h"
+)
+
+//trashPurger periodically purges trash older than retention
+type trashPurger struct {
+	db        maddendb.Madden
+	retention time.Duration
+	interval  time.Duration
+}
+
+//newTrashPurgerFromEnvironment builds a purger from the environment, returning nil if purging is disabled by a retention of 0 days
+func newTrashPurgerFromEnvironment(db maddendb.Madden) (*trashPurger, error) {
+	days, err := strconv.Atoi(utilities.GetEnvDefaultAndLog(TRASH_RETENTION_DAYS_ENV, TRASH_RETENTION_DAYS_DEFAULT))
+	if err != nil || days < 0 {
+		return nil, fmt.Errorf("%s must be a non negative number of days", TRASH_RETENTION_DAYS_ENV)
+	}
+	interval, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(TRASH_PURGE_INTERVAL_ENV, TRASH_PURGE_INTERVAL_DEFAULT))
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("%s must be a positive duration such as 1h", TRASH_PURGE_INTERVAL_ENV)
+	}
+	if days == 0 {
+		return nil, nil
+	}
+	return &trashPurger{db: db, retention: time.Duration(days) * 24 * time.Hour, interval: interval}, nil
+}
+
+//run purges once immediately then once every interval, it never returns
+func (purger *trashPurger) run() {
+	ticker := time.NewTicker(purger.interval)
+	defer ticker.Stop()
+	for {
+		purger.purge()
+		<-ticker.C
+	}
+}
+
+//purge hard deletes trash older than the retention period, logging the outcome
+func (purger *trashPurger) purge() {
+	cutoff := time.Now().Add(-purger.retention)
+	//bounded by the interval so a stuck purge never overlaps the next run
+	ctx, cancel := context.WithTimeout(context.Background(), purger.interval)
+	defer cancel()
+	purged, err := purger.db.PurgeDeleted(ctx, cutoff)
+	if err != nil {
+		fmt.Printf("trash purge failed ERROR: %s\n", err.Error())
+		return
+	}
+	fmt.Printf("trash purge removed %d items and %d images deleted before %s\n", purged.Items, purged.Images, cutoff.UTC().Format(time.RFC3339))
+}
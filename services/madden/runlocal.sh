@@ -7,4 +7,5 @@ export DB_HOST=localhost
 export DB_PORT=9876
 export IMAGE_PATH=http://www.google.com/
 
+go run . migrate up
 go run .
\ No newline at end of file
@@ -0,0 +1,143 @@
+package swagger
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+//conditional reads for clients polling entries, the summary or the published state
+
+//responses kept by a ConditionalClient, the least recently read url is dropped beyond this
+const CONDITIONAL_RESPONSE_LIMIT = 64
+
+//ConditionalClient wraps a Client, sending the validators of the previous response to each read of the same url
+//a 304 answer is served from the body kept with those validators, it is safe for concurrent use
+type ConditionalClient struct {
+	Client ClientInterface
+	lock   sync.Mutex
+	//elements of order by url, the front of order is the most recently read
+	responses map[string]*list.Element
+	order     *list.List
+}
+
+//conditionalResponse is a body, the url it was read from and the validators it was sent with
+type conditionalResponse struct {
+	url          string
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+//NewConditionalClient creates a ConditionalClient for server, see NewClient
+func NewConditionalClient(server string, opts ...ClientOption) (*ConditionalClient, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalClient{Client: client, responses: map[string]*list.Element{}, order: list.New()}, nil
+}
+
+//GetEntry reads a page of entries, the returned bool is false if the page is unchanged since the previous read of the same params
+func (client *ConditionalClient) GetEntry(ctx context.Context, params *GetEntryParams, reqEditors ...RequestEditorFn) (*MaintenanceItems, bool, error) {
+	entries := &MaintenanceItems{}
+	changed, err := client.read(ctx, func(editors []RequestEditorFn) (*http.Response, error) {
+		return client.Client.GetEntry(ctx, params, editors...)
+	}, reqEditors, entries)
+	if err != nil {
+		return nil, false, err
+	}
+	return entries, changed, nil
+}
+
+//GetSummary reads the summary, the returned bool is false if it is unchanged since the previous read
+func (client *ConditionalClient) GetSummary(ctx context.Context, reqEditors ...RequestEditorFn) (*Summary, bool, error) {
+	summary := &Summary{}
+	changed, err := client.read(ctx, func(editors []RequestEditorFn) (*http.Response, error) {
+		return client.Client.GetSummary(ctx, editors...)
+	}, reqEditors, summary)
+	if err != nil {
+		return nil, false, err
+	}
+	return summary, changed, nil
+}
+
+//GetPublished reads the published state, the returned bool is false if it is unchanged since the previous read
+func (client *ConditionalClient) GetPublished(ctx context.Context, reqEditors ...RequestEditorFn) (*Published, bool, error) {
+	published := &Published{}
+	changed, err := client.read(ctx, func(editors []RequestEditorFn) (*http.Response, error) {
+		return client.Client.GetPublished(ctx, editors...)
+	}, reqEditors, published)
+	if err != nil {
+		return nil, false, err
+	}
+	return published, changed, nil
+}
+
+//read sends a request through send with the validators kept for its url and decodes the body of the answer into out
+//returns true if the server sent a new body
+func (client *ConditionalClient) read(ctx context.Context, send func([]RequestEditorFn) (*http.Response, error), reqEditors []RequestEditorFn, out interface{}) (bool, error) {
+	url := ""
+	kept, held := conditionalResponse{}, false
+	validators := func(ctx context.Context, req *http.Request) error {
+		url = req.URL.String()
+		kept, held = client.kept(url)
+		if held && kept.etag != "" {
+			req.Header.Set("If-None-Match", kept.etag)
+		}
+		if held && kept.lastModified != "" {
+			req.Header.Set("If-Modified-Since", kept.lastModified)
+		}
+		return nil
+	}
+	rsp, err := send(append([]RequestEditorFn{validators}, reqEditors...))
+	if err != nil {
+		return false, err
+	}
+	defer rsp.Body.Close()
+	body, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case rsp.StatusCode == http.StatusNotModified && held:
+		return false, json.Unmarshal(kept.body, out)
+	case rsp.StatusCode == http.StatusOK:
+		client.keep(conditionalResponse{url: url, etag: rsp.Header.Get("ETag"), lastModified: rsp.Header.Get("Last-Modified"), body: body})
+		return true, json.Unmarshal(body, out)
+	}
+	return false, fmt.Errorf("unexpected response %d: %s", rsp.StatusCode, body)
+}
+
+//kept returns the response kept for url, marking it the most recently read
+func (client *ConditionalClient) kept(url string) (conditionalResponse, bool) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	element, held := client.responses[url]
+	if !held {
+		return conditionalResponse{}, false
+	}
+	client.order.MoveToFront(element)
+	return element.Value.(conditionalResponse), true
+}
+
+//keep replaces the response kept for its url, dropping the least recently read beyond CONDITIONAL_RESPONSE_LIMIT
+func (client *ConditionalClient) keep(response conditionalResponse) {
+	client.lock.Lock()
+	defer client.lock.Unlock()
+	if element, held := client.responses[response.url]; held {
+		element.Value = response
+		client.order.MoveToFront(element)
+		return
+	}
+	client.responses[response.url] = client.order.PushFront(response)
+	for client.order.Len() > CONDITIONAL_RESPONSE_LIMIT {
+		oldest := client.order.Back()
+		client.order.Remove(oldest)
+		delete(client.responses, oldest.Value.(conditionalResponse).url)
+	}
+}
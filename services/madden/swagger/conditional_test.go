@@ -0,0 +1,135 @@
+package swagger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+//conditionalServer answers every url with a body tagged by the version of that url, 304 if the request names the version
+type conditionalServer struct {
+	lock     sync.Mutex
+	versions map[string]int
+	//requests answered with 304
+	notModified int
+}
+
+func (server *conditionalServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	version := server.versions[request.URL.String()]
+	tag := fmt.Sprintf(`"%d"`, version)
+	writer.Header().Set("ETag", tag)
+	if request.Header.Get("If-None-Match") == tag {
+		server.notModified++
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(writer, `{"summary": "%s version %d"}`, request.URL.Path, version)
+}
+
+//change moves the body of url on to its next version
+func (server *conditionalServer) change(url string) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.versions[url]++
+}
+
+func TestConditionalClientReplay(t *testing.T) {
+	server := &conditionalServer{versions: map[string]int{}}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	client, err := NewConditionalClient(httpServer.URL)
+	if err != nil {
+		t.Fatalf("unable to create client ERROR: %s\n", err.Error())
+	}
+	tests := []struct {
+		name            string
+		change          bool
+		expectedChanged bool
+		expected        string
+	}{
+		{name: "first read", expectedChanged: true, expected: "/summary version 0"},
+		{name: "unchanged", expectedChanged: false, expected: "/summary version 0"},
+		{name: "still unchanged", expectedChanged: false, expected: "/summary version 0"},
+		{name: "changed", change: true, expectedChanged: true, expected: "/summary version 1"},
+		{name: "unchanged after the change", expectedChanged: false, expected: "/summary version 1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.change {
+				server.change("/summary")
+			}
+			summary, changed, err := client.GetSummary(context.Background())
+			if err != nil {
+				t.Fatalf("unable to read summary ERROR: %s\n", err.Error())
+			}
+			if changed != test.expectedChanged || summary.Summary != test.expected {
+				t.Errorf("expected %q changed %t got %q changed %t\n", test.expected, test.expectedChanged, summary.Summary, changed)
+			}
+		})
+	}
+	if server.notModified != 3 {
+		t.Errorf("expected 3 reads answered with 304 got %d\n", server.notModified)
+	}
+}
+
+func TestConditionalClientUrls(t *testing.T) {
+	server := &conditionalServer{versions: map[string]int{}}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	client, err := NewConditionalClient(httpServer.URL)
+	if err != nil {
+		t.Fatalf("unable to create client ERROR: %s\n", err.Error())
+	}
+	first, second := 1, 2
+	//polling two pages in turn keeps both
+	for round := 0; round < 3; round++ {
+		for _, pageNumber := range []int{first, second} {
+			_, changed, err := client.GetEntry(context.Background(), &GetEntryParams{PageNumber: &pageNumber})
+			if err != nil {
+				t.Fatalf("unable to read entries ERROR: %s\n", err.Error())
+			}
+			if changed != (round == 0) {
+				t.Errorf("expected page %d changed only on the first round got changed %t in round %d\n", pageNumber, changed, round)
+			}
+		}
+	}
+	if server.notModified != 4 {
+		t.Errorf("expected 4 reads answered with 304 got %d\n", server.notModified)
+	}
+}
+
+func TestConditionalClientLimit(t *testing.T) {
+	server := &conditionalServer{versions: map[string]int{}}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	client, err := NewConditionalClient(httpServer.URL)
+	if err != nil {
+		t.Fatalf("unable to create client ERROR: %s\n", err.Error())
+	}
+	read := func(pageNumber int) bool {
+		_, changed, err := client.GetEntry(context.Background(), &GetEntryParams{PageNumber: &pageNumber})
+		if err != nil {
+			t.Fatalf("unable to read entries ERROR: %s\n", err.Error())
+		}
+		return changed
+	}
+	for pageNumber := 0; pageNumber <= CONDITIONAL_RESPONSE_LIMIT; pageNumber++ {
+		read(pageNumber)
+	}
+	if held := client.order.Len(); held != CONDITIONAL_RESPONSE_LIMIT {
+		t.Errorf("expected %d responses kept got %d\n", CONDITIONAL_RESPONSE_LIMIT, held)
+	}
+	//the first page was read least recently so it was dropped, the last was kept
+	if !read(0) {
+		t.Errorf("expected the least recently read page to be read again\n")
+	}
+	if read(CONDITIONAL_RESPONSE_LIMIT) {
+		t.Errorf("expected the most recently read page to be kept\n")
+	}
+}
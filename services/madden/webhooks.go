@@ -0,0 +1,180 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"../services/maddendb"
+	"../services/utilities"
+)
+
+//the webhook dispatcher, posts deliveries queued in the outbox to their subscriptions retrying failures with exponential backoff
+
+const (
+	WEBHOOK_POLL_INTERVAL_ENV     = "WEBHOOK_POLL_INTERVAL"
+	WEBHOOK_TIMEOUT_ENV           = "WEBHOOK_TIMEOUT"
+	WEBHOOK_MAX_ATTEMPTS_ENV      = "WEBHOOK_MAX_ATTEMPTS"
+	WEBHOOK_POLL_INTERVAL_DEFAULT = "5s"
+	WEBHOOK_TIMEOUT_DEFAULT       = "10s"
+	WEBHOOK_MAX_ATTEMPTS_DEFAULT  = "8"
+	//the wait after the first failed attempt, doubled after each further failure up to WEBHOOK_BACKOFF_MAX
+	WEBHOOK_BACKOFF_BASE = 30 * time.Second
+	WEBHOOK_BACKOFF_MAX  = time.Hour
+	//deliveries claimed per poll, every claimed delivery is attempted at once
+	WEBHOOK_BATCH_SIZE = 50
+	//added to the timeout to lease claimed deliveries, a delivery whose claimant dies is attempted again once its lease ends
+	WEBHOOK_LEASE_MARGIN = 30 * time.Second
+	//the most of a response body read before the connection is reused
+	WEBHOOK_RESPONSE_LIMIT = 64 * 1024
+	//headers sent with every delivery, the signature is the hex HMAC-SHA256 of the body keyed by the webhook secret
+	WEBHOOK_SIGNATURE_HEADER = "X-Madden-Signature"
+	WEBHOOK_EVENT_HEADER     = "X-Madden-Event"
+	WEBHOOK_DELIVERY_HEADER  = "X-Madden-Delivery"
+	WEBHOOK_SIGNATURE_PREFIX = "sha256="
+)
+
+//webhookDispatcher periodically attempts the due deliveries of the outbox
+type webhookDispatcher struct {
+	db          maddendb.Madden
+	client      *http.Client
+	interval    time.Duration
+	timeout     time.Duration
+	maxAttempts uint
+}
+
+//newWebhookDispatcherFromEnvironment builds a dispatcher from the environment, returning nil if dispatch is disabled by a poll interval of 0
+func newWebhookDispatcherFromEnvironment(db maddendb.Madden) (*webhookDispatcher, error) {
+	interval, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(WEBHOOK_POLL_INTERVAL_ENV, WEBHOOK_POLL_INTERVAL_DEFAULT))
+	if err != nil || interval < 0 {
+		return nil, fmt.Errorf("%s must be a non negative duration such as 5s", WEBHOOK_POLL_INTERVAL_ENV)
+	}
+	timeout, err := time.ParseDuration(utilities.GetEnvDefaultAndLog(WEBHOOK_TIMEOUT_ENV, WEBHOOK_TIMEOUT_DEFAULT))
+	if err != nil || timeout <= 0 {
+		return nil, fmt.Errorf("%s must be a positive duration such as 10s", WEBHOOK_TIMEOUT_ENV)
+	}
+	maxAttempts, err := strconv.Atoi(utilities.GetEnvDefaultAndLog(WEBHOOK_MAX_ATTEMPTS_ENV, WEBHOOK_MAX_ATTEMPTS_DEFAULT))
+	if err != nil || maxAttempts < 1 {
+		return nil, fmt.Errorf("%s must be a positive number of attempts", WEBHOOK_MAX_ATTEMPTS_ENV)
+	}
+	if interval == 0 {
+		return nil, nil
+	}
+	return &webhookDispatcher{
+		db:          db,
+		client:      &http.Client{Timeout: timeout},
+		interval:    interval,
+		timeout:     timeout,
+		maxAttempts: uint(maxAttempts),
+	}, nil
+}
+
+//run dispatches once immediately then once every interval, it never returns
+func (dispatcher *webhookDispatcher) run() {
+	ticker := time.NewTicker(dispatcher.interval)
+	defer ticker.Stop()
+	for {
+		dispatcher.dispatch()
+		<-ticker.C
+	}
+}
+
+//dispatch attempts batches of due deliveries until none are left
+func (dispatcher *webhookDispatcher) dispatch() {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), dispatcher.timeout)
+		deliveries, err := dispatcher.db.ClaimWebhookDeliveries(ctx, time.Now(), WEBHOOK_BATCH_SIZE, dispatcher.timeout+WEBHOOK_LEASE_MARGIN)
+		cancel()
+		if err != nil {
+			fmt.Printf("webhook dispatch failed ERROR: %s\n", err.Error())
+			return
+		}
+		wait := sync.WaitGroup{}
+		for _, delivery := range deliveries {
+			wait.Add(1)
+			go func(delivery maddendb.WebhookDelivery) {
+				defer wait.Done()
+				dispatcher.attempt(delivery)
+			}(delivery)
+		}
+		wait.Wait()
+		if len(deliveries) < WEBHOOK_BATCH_SIZE {
+			return
+		}
+	}
+}
+
+//attempt posts delivery to its subscription and records the outcome
+func (dispatcher *webhookDispatcher) attempt(delivery maddendb.WebhookDelivery) {
+	postErr := dispatcher.post(delivery)
+	ctx, cancel := context.WithTimeout(context.Background(), dispatcher.timeout)
+	defer cancel()
+	var err error
+	if postErr == nil {
+		err = dispatcher.db.CompleteWebhookDelivery(ctx, delivery.ID, time.Now())
+	} else {
+		retryAt := dispatcher.retryAt(delivery.Attempts+1, time.Now())
+		if retryAt == nil {
+			fmt.Printf("webhook delivery %d to %s is dead after %d attempts ERROR: %s\n", delivery.ID, delivery.WebhookSubscription.Url, delivery.Attempts+1, postErr.Error())
+		}
+		err = dispatcher.db.FailWebhookDelivery(ctx, delivery.ID, postErr.Error(), retryAt)
+	}
+	if err != nil {
+		fmt.Printf("unable to record webhook delivery %d ERROR: %s\n", delivery.ID, err.Error())
+	}
+}
+
+//post sends the payload of delivery signed with the secret of its subscription, any status other than 2xx is a failure
+func (dispatcher *webhookDispatcher) post(delivery maddendb.WebhookDelivery) error {
+	body := []byte(delivery.Payload)
+	request, err := http.NewRequest(http.MethodPost, delivery.WebhookSubscription.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WEBHOOK_EVENT_HEADER, delivery.Event)
+	request.Header.Set(WEBHOOK_DELIVERY_HEADER, strconv.FormatUint(uint64(delivery.ID), 10))
+	request.Header.Set(WEBHOOK_SIGNATURE_HEADER, signWebhookPayload(delivery.WebhookSubscription.Secret, body))
+	response, err := dispatcher.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(response.Body, WEBHOOK_RESPONSE_LIMIT))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", response.StatusCode)
+	}
+	return nil
+}
+
+//retryAt returns when to retry a delivery that has failed attempts times, nil once no attempts are left
+func (dispatcher *webhookDispatcher) retryAt(attempts uint, now time.Time) *time.Time {
+	if attempts >= dispatcher.maxAttempts {
+		return nil
+	}
+	backoff := WEBHOOK_BACKOFF_BASE
+	for i := uint(1); i < attempts && backoff < WEBHOOK_BACKOFF_MAX; i++ {
+		backoff *= 2
+	}
+	if backoff > WEBHOOK_BACKOFF_MAX {
+		backoff = WEBHOOK_BACKOFF_MAX
+	}
+	retry := now.Add(backoff)
+	return &retry
+}
+
+//signWebhookPayload returns the signature header value of body keyed by secret
+func signWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return WEBHOOK_SIGNATURE_PREFIX + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		secret   string
+		body     string
+		expected string
+	}{
+		//RFC 4231 test case 2
+		{name: "rfc 4231", secret: "Jefe", body: "what do ya want for nothing?", expected: "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"},
+		{name: "sentence", secret: "key", body: "The quick brown fox jumps over the lazy dog", expected: "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"},
+		{name: "empty", secret: "", body: "", expected: "b613679a0814d9ec772f95d778c35fc5ff1697c493715653c6c712144292c5ad"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if signature := signWebhookPayload(test.secret, []byte(test.body)); signature != WEBHOOK_SIGNATURE_PREFIX+test.expected {
+				t.Errorf("expected %s got %s\n", WEBHOOK_SIGNATURE_PREFIX+test.expected, signature)
+			}
+		})
+	}
+}
+
+func TestRetryAt(t *testing.T) {
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	tests := []struct {
+		name        string
+		maxAttempts uint
+		attempts    uint
+		//the expected wait before the retry, ignored if noRetry is set
+		expected time.Duration
+		noRetry  bool
+	}{
+		{name: "first failure", maxAttempts: 8, attempts: 1, expected: WEBHOOK_BACKOFF_BASE},
+		{name: "second failure doubles", maxAttempts: 8, attempts: 2, expected: 2 * WEBHOOK_BACKOFF_BASE},
+		{name: "last retry", maxAttempts: 8, attempts: 7, expected: 64 * WEBHOOK_BACKOFF_BASE},
+		{name: "no attempts left", maxAttempts: 8, attempts: 8, noRetry: true},
+		{name: "past the attempts", maxAttempts: 8, attempts: 9, noRetry: true},
+		{name: "single attempt", maxAttempts: 1, attempts: 1, noRetry: true},
+		{name: "under the cap", maxAttempts: 20, attempts: 7, expected: 32 * time.Minute},
+		{name: "capped", maxAttempts: 20, attempts: 8, expected: WEBHOOK_BACKOFF_MAX},
+		{name: "stays capped", maxAttempts: 20, attempts: 19, expected: WEBHOOK_BACKOFF_MAX},
+		{name: "capped without overflow", maxAttempts: 1000, attempts: 999, expected: WEBHOOK_BACKOFF_MAX},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dispatcher := webhookDispatcher{maxAttempts: test.maxAttempts}
+			retry := dispatcher.retryAt(test.attempts, now)
+			if test.noRetry {
+				if retry != nil {
+					t.Errorf("expected no retry got %s\n", retry)
+				}
+				return
+			}
+			if retry == nil {
+				t.Fatalf("expected a retry after %s got none\n", test.expected)
+			}
+			if wait := retry.Sub(now); wait != test.expected {
+				t.Errorf("expected a retry after %s got %s\n", test.expected, wait)
+			}
+		})
+	}
+}
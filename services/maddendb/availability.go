@@ -0,0 +1,149 @@
+package maddendb
+
+import (
+	"sort"
+)
+
+//time images spent in each status over a range, the worst status booked for an image by an active item wins while items overlap
+
+//statuses from best to worst, indexed by their rank
+var rankedStatuses = []string{STATUS_FMC, STATUS_PMC, STATUS_NMC}
+
+//PeriodAvailability is the time every image spent in each status between Start and End
+type PeriodAvailability struct {
+	Start int64
+	End   int64
+	//every live image and any image booked by an item window in the period, in order of id
+	Images []ImageAvailability
+}
+
+//ImageAvailability is the time in seconds an image spent in each status during a period, the three add up to the length of the period
+type ImageAvailability struct {
+	MaddenImageFile MaddenImageFile
+	FMC             int64
+	PMC             int64
+	NMC             int64
+}
+
+//add adds seconds to the time spent in status
+func (availability *ImageAvailability) add(status string, seconds int64) {
+	switch status {
+	case STATUS_NMC:
+		availability.NMC += seconds
+	case STATUS_PMC:
+		availability.PMC += seconds
+	default:
+		availability.FMC += seconds
+	}
+}
+
+//statusEdge is the start or end of a window booking an image with the status of rank
+type statusEdge struct {
+	at    int64
+	rank  int
+	delta int
+}
+
+//availabilityBoundariesValid ensures there is at least one period and the boundaries ascend
+func availabilityBoundariesValid(boundaries []int64) error {
+	if len(boundaries) < 2 {
+		return &DbError{Message: "availability needs at least two boundaries"}
+	}
+	for i := 1; i < len(boundaries); i++ {
+		if boundaries[i] <= boundaries[i-1] {
+			return &DbError{Message: "availability boundaries must ascend"}
+		}
+	}
+	return nil
+}
+
+//buildAvailability splits the range of boundaries into periods and sums the time each image spent in each status during them
+//boundaries must be ascending, consecutive boundaries being the start and end of a period
+//images booked by a window but missing from images are added from the window
+func buildAvailability(boundaries []int64, images []MaddenImageFile, windows []MaddenItem) []PeriodAvailability {
+	from, to := boundaries[0], boundaries[len(boundaries)-1]
+	files := map[uint]MaddenImageFile{}
+	for _, image := range images {
+		files[image.ID] = image
+	}
+	edges := map[uint][]statusEdge{}
+	for _, window := range windows {
+		begin, end := window.BeginDate, window.EndDate
+		if begin < from {
+			begin = from
+		}
+		if end > to {
+			end = to
+		}
+		if begin >= end {
+			continue
+		}
+		for _, itemImage := range window.ItemImages {
+			id := itemImageFileId(itemImage)
+			if _, exists := files[id]; !exists {
+				file := itemImage.MaddenImageFile
+				file.ID = id
+				files[id] = file
+			}
+			rank := statusRanks[itemImage.Status]
+			edges[id] = append(edges[id], statusEdge{at: begin, rank: rank, delta: 1}, statusEdge{at: end, rank: rank, delta: -1})
+		}
+	}
+	ids := []uint{}
+	for id := range files {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	periods := []PeriodAvailability{}
+	for i := 1; i < len(boundaries); i++ {
+		period := PeriodAvailability{Start: boundaries[i-1], End: boundaries[i], Images: []ImageAvailability{}}
+		for _, id := range ids {
+			period.Images = append(period.Images, ImageAvailability{MaddenImageFile: files[id]})
+		}
+		periods = append(periods, period)
+	}
+	for index, id := range ids {
+		sweepAvailability(boundaries, edges[id], periods, index)
+	}
+	return periods
+}
+
+//sweepAvailability walks the edges of one image across the periods, adding the time between edges to the worst status active then
+//index is the position of the image within the images of each period
+func sweepAvailability(boundaries []int64, edges []statusEdge, periods []PeriodAvailability, index int) {
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].at < edges[j].at
+	})
+	active := make([]int, len(rankedStatuses))
+	current, period := boundaries[0], 0
+	advance := func(until int64) {
+		for current < until && period < len(periods) {
+			end := periods[period].End
+			if until < end {
+				end = until
+			}
+			periods[period].Images[index].add(worstActive(active), end-current)
+			current = end
+			if current == periods[period].End {
+				period++
+			}
+		}
+	}
+	for _, edge := range edges {
+		advance(edge.at)
+		active[edge.rank] += edge.delta
+	}
+	advance(boundaries[len(boundaries)-1])
+}
+
+//worstActive returns the worst status with an active window, FMC if there are none
+func worstActive(active []int) string {
+	for rank := len(active) - 1; rank > 0; rank-- {
+		if active[rank] > 0 {
+			return rankedStatuses[rank]
+		}
+	}
+	return STATUS_FMC
+}
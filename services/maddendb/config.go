@@ -16,3 +16,16 @@ func BuildPostgresMaintenanceFromEnvironment() (Maintenance, error) {
 	}
 	return NewPostgresMaintenace(db), nil
 }
+
+//sets up a postgres migrator from environment variables
+func BuildPostgresMigratorFromEnvironment() (Migrator, error) {
+	config, err := dbutils.NewConfigFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	db, err := dbutils.NewGormDb(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewPostgresMigrator(db)
+}
@@ -0,0 +1,217 @@
+package maddendb
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+//defines conflicts between madden items, windows of time booking the same image file
+//the windows of an item are the item itself, or the occurrences of a recurring item
+
+const (
+	//how far past its start a recurring item is checked for conflicts when it is written, series that end sooner are checked to their end
+	CONFLICT_HORIZON = 366 * 24 * time.Hour
+)
+
+//ItemConflict is a pair of windows booking at least one shared image file over overlapping times
+type ItemConflict struct {
+	//the window starting first, or the item being written when checking a write
+	First MaddenItem
+	//the other window, items and occurrences alike carry OccurrenceStart when they are occurrences of a recurring item
+	Second MaddenItem
+	//image files booked by both windows in order of id
+	Images []ConflictImage
+}
+
+//ConflictImage is an image file booked by both windows of a conflict along with the status each gives it
+type ConflictImage struct {
+	MaddenImageFileId uint
+	FirstStatus       string
+	SecondStatus      string
+}
+
+//Contradictory returns true if the windows of the conflict give a shared image file different statuses
+func (conflict ItemConflict) Contradictory() bool {
+	for _, image := range conflict.Images {
+		if image.FirstStatus != image.SecondStatus {
+			return true
+		}
+	}
+	return false
+}
+
+//itemWindows returns the windows of items that start before to and end after from, recurring items are expanded into their occurrences
+func itemWindows(items []MaddenItem, from, to int64) ([]MaddenItem, error) {
+	windows := []MaddenItem{}
+	series := []MaddenItem{}
+	for _, item := range items {
+		if item.RRule != "" {
+			series = append(series, item)
+		} else if item.BeginDate < to && item.EndDate > from {
+			windows = append(windows, item)
+		}
+	}
+	occurrences, err := expandAllSeries(series, to, from, func(MaddenItem) bool { return true }, MAX_RECURRENCE_PERIODS)
+	if err != nil {
+		return nil, err
+	}
+	return append(windows, occurrences...), nil
+}
+
+//candidateWindows returns the windows of item as it would be written and the range they cover
+//recurring items are checked up to CONFLICT_HORIZON after they start, exceptions are expected in item.Occurrences
+func candidateWindows(item MaddenItem) ([]MaddenItem, int64, int64, error) {
+	if item.RRule == "" {
+		return []MaddenItem{item}, item.BeginDate, item.EndDate, nil
+	}
+	to := item.BeginDate + int64(CONFLICT_HORIZON/time.Second)
+	if end := seriesEnd(item); end != 0 && end < to {
+		to = end
+	}
+	windows, err := itemWindows([]MaddenItem{item}, item.BeginDate, to)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	from := to
+	for _, window := range windows {
+		if window.BeginDate < from {
+			from = window.BeginDate
+		}
+		if window.EndDate > to {
+			to = window.EndDate
+		}
+	}
+	return windows, from, to, nil
+}
+
+//writeConflicts returns the conflicts of windows, those a write adds for a single item, with the windows of other items read by findWindows
+//the write must hold locks keeping other writes to the images from being checked until it is done, with strict an OverlapError is returned if there are any
+func writeConflicts(windows []MaddenItem, strict bool, findWindows func(from, to int64, imageIds []uint) ([]MaddenItem, error)) ([]ItemConflict, error) {
+	if len(windows) == 0 || len(windows[0].ItemImages) == 0 {
+		return []ItemConflict{}, nil
+	}
+	from, to := windows[0].BeginDate, windows[0].EndDate
+	for _, window := range windows {
+		if window.BeginDate < from {
+			from = window.BeginDate
+		}
+		if window.EndDate > to {
+			to = window.EndDate
+		}
+	}
+	others, err := findWindows(from, to, itemImageIds(windows[0]))
+	if err != nil {
+		return nil, err
+	}
+	conflicts := candidateConflicts(windows, others)
+	if strict && len(conflicts) > 0 {
+		return nil, &OverlapError{Message: fmt.Sprintf("entry overlaps %d windows of other entries on a shared image", len(conflicts)), Conflicts: conflicts}
+	}
+	return conflicts, nil
+}
+
+//occurrenceWindows returns the window an edit of the occurrence of series adds, a cancellation adds none
+func occurrenceWindows(series MaddenItem, occurrence ItemOccurrence) []MaddenItem {
+	if occurrence.Cancelled {
+		return []MaddenItem{}
+	}
+	return []MaddenItem{occurrenceItem(series, occurrence.OccurrenceStart, &occurrence)}
+}
+
+//unwrittenOverlap clears the id of the item a strict create refused from err, it was never written
+func unwrittenOverlap(err error, id uint) error {
+	if overlap, ok := err.(*OverlapError); ok {
+		for i := range overlap.Conflicts {
+			overlap.Conflicts[i].First.ID = id
+		}
+	}
+	return err
+}
+
+//itemImageIds returns the ids of the image files item books
+func itemImageIds(item MaddenItem) []uint {
+	ids := []uint{}
+	for _, itemImage := range item.ItemImages {
+		ids = append(ids, itemImageFileId(itemImage))
+	}
+	return ids
+}
+
+//windowConflict returns the conflict between first and second, false if they do not overlap or share no image file
+//windows of the same item never conflict
+func windowConflict(first, second MaddenItem) (ItemConflict, bool) {
+	if first.ID == second.ID || first.BeginDate >= second.EndDate || second.BeginDate >= first.EndDate {
+		return ItemConflict{}, false
+	}
+	secondStatuses := map[uint]string{}
+	for _, itemImage := range second.ItemImages {
+		secondStatuses[itemImageFileId(itemImage)] = itemImage.Status
+	}
+	conflict := ItemConflict{First: first, Second: second, Images: []ConflictImage{}}
+	seen := map[uint]bool{}
+	for _, itemImage := range first.ItemImages {
+		id := itemImageFileId(itemImage)
+		secondStatus, shared := secondStatuses[id]
+		if !shared || seen[id] {
+			continue
+		}
+		seen[id] = true
+		conflict.Images = append(conflict.Images, ConflictImage{MaddenImageFileId: id, FirstStatus: itemImage.Status, SecondStatus: secondStatus})
+	}
+	if len(conflict.Images) == 0 {
+		return ItemConflict{}, false
+	}
+	sort.Slice(conflict.Images, func(i, j int) bool {
+		return conflict.Images[i].MaddenImageFileId < conflict.Images[j].MaddenImageFileId
+	})
+	return conflict, true
+}
+
+//windowConflicts returns every conflict among windows, ordered by the start of the first window and then of the second
+func windowConflicts(windows []MaddenItem) []ItemConflict {
+	sorted := append([]MaddenItem{}, windows...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return windowLess(sorted[i], sorted[j])
+	})
+	conflicts := []ItemConflict{}
+	for i := range sorted {
+		//windows are sorted by start so none after the first starting at or after this window ends can overlap it
+		for j := i + 1; j < len(sorted) && sorted[j].BeginDate < sorted[i].EndDate; j++ {
+			if conflict, found := windowConflict(sorted[i], sorted[j]); found {
+				conflicts = append(conflicts, conflict)
+			}
+		}
+	}
+	return conflicts
+}
+
+//candidateConflicts returns the conflicts of each candidate window with the others, candidates are always first
+func candidateConflicts(candidates, others []MaddenItem) []ItemConflict {
+	sort.Slice(candidates, func(i, j int) bool {
+		return windowLess(candidates[i], candidates[j])
+	})
+	sort.Slice(others, func(i, j int) bool {
+		return windowLess(others[i], others[j])
+	})
+	conflicts := []ItemConflict{}
+	for _, candidate := range candidates {
+		for _, other := range others {
+			if conflict, found := windowConflict(candidate, other); found {
+				conflicts = append(conflicts, conflict)
+			}
+		}
+	}
+	return conflicts
+}
+
+//windowLess orders windows by start, then by item id and occurrence
+func windowLess(first, second MaddenItem) bool {
+	if first.BeginDate != second.BeginDate {
+		return first.BeginDate < second.BeginDate
+	}
+	if first.ID != second.ID {
+		return first.ID < second.ID
+	}
+	return first.OccurrenceStart < second.OccurrenceStart
+}
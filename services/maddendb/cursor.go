@@ -0,0 +1,90 @@
+package maddendb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//defines keyset pagination cursors, cursors are opaque to clients and only compared against rows sorted the same way
+
+//ItemCursor marks the last madden item of a page, the next page starts after it in the order given by SortField
+type ItemCursor struct {
+	SortField SortField `json:"s"`
+	BeginDate int64     `json:"b"`
+	EndDate   int64     `json:"e"`
+	ID        uint      `json:"i"`
+}
+
+//ImageCursor marks the last madden image of a page, images are ordered by creation time then id
+type ImageCursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uint      `json:"i"`
+}
+
+//NewItemCursor returns a cursor positioned after item for pages sorted by sortField
+func NewItemCursor(item MaddenItem, sortField SortField) ItemCursor {
+	return ItemCursor{SortField: sortField, BeginDate: item.BeginDate, EndDate: item.EndDate, ID: item.ID}
+}
+
+//NewImageCursor returns a cursor positioned after image
+func NewImageCursor(image MaddenImageFile) ImageCursor {
+	return ImageCursor{CreatedAt: image.CreatedAt, ID: image.ID}
+}
+
+//Encode returns the opaque token form of the cursor
+func (cursor ItemCursor) Encode() string {
+	return encodeCursor(cursor)
+}
+
+//Encode returns the opaque token form of the cursor
+func (cursor ImageCursor) Encode() string {
+	return encodeCursor(cursor)
+}
+
+//DecodeItemCursor parses a token returned by ItemCursor.Encode
+func DecodeItemCursor(token string) (ItemCursor, error) {
+	cursor := ItemCursor{}
+	err := decodeCursor(token, &cursor)
+	return cursor, err
+}
+
+//DecodeImageCursor parses a token returned by ImageCursor.Encode
+func DecodeImageCursor(token string) (ImageCursor, error) {
+	cursor := ImageCursor{}
+	err := decodeCursor(token, &cursor)
+	return cursor, err
+}
+
+//keyset returns the cursor values in the same order as the sort columns
+func (cursor ItemCursor) keyset() []interface{} {
+	if cursor.SortField == StartDate {
+		return []interface{}{cursor.BeginDate, cursor.EndDate, cursor.ID}
+	}
+	return []interface{}{cursor.EndDate, cursor.BeginDate, cursor.ID}
+}
+
+//precedes returns true if item follows the cursor in the order given by SortField
+func (cursor ItemCursor) precedes(item MaddenItem) bool {
+	return itemLess(&MaddenItem{Model: gorm.Model{ID: cursor.ID}, BeginDate: cursor.BeginDate, EndDate: cursor.EndDate}, &item, cursor.SortField)
+}
+
+func encodeCursor(cursor interface{}) string {
+	//cursors only hold numbers and times, marshalling can not fail
+	encoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func decodeCursor(token string, cursor interface{}) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return &DbError{Message: "cursor was invalid", OriginalError: err}
+	}
+	if err := json.Unmarshal(decoded, cursor); err != nil {
+		return &DbError{Message: "cursor was invalid", OriginalError: fmt.Errorf("cursor did not decode: %w", err)}
+	}
+	return nil
+}
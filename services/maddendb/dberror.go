@@ -11,3 +11,56 @@ type DbError struct {
 func (dbError *DbError) Error() string {
 	return dbError.Message
 }
+
+//Unwrap exposes the original error to errors.Is and errors.As, such as a cancelled or expired context
+func (dbError *DbError) Unwrap() error {
+	return dbError.OriginalError
+}
+
+//ConflictError is returned when a write would duplicate an existing madden item or image
+type ConflictError struct {
+	Message string
+	//id of the madden item or image that would have been duplicated
+	ExistingId uint
+}
+
+//Error Interface Implementation
+func (conflictError *ConflictError) Error() string {
+	return conflictError.Message
+}
+
+//OverlapError is returned when a strict write would book an image file another item books over an overlapping time
+type OverlapError struct {
+	Message string
+	//the conflicts the write would have had, the written item is the first window of each
+	Conflicts []ItemConflict
+}
+
+//Error Interface Implementation
+func (overlapError *OverlapError) Error() string {
+	return overlapError.Message
+}
+
+//VersionConflictError is returned when an update expected a version other than the stored version
+type VersionConflictError struct {
+	Message string
+	//version currently stored
+	CurrentVersion uint
+}
+
+//Error Interface Implementation
+func (versionError *VersionConflictError) Error() string {
+	return versionError.Message
+}
+
+//ImageInUseError is returned when an image can not be deleted because live madden items still link it
+type ImageInUseError struct {
+	Message string
+	//ids of the madden items linking the image, ascending
+	ItemIds []uint
+}
+
+//Error Interface Implementation
+func (inUseError *ImageInUseError) Error() string {
+	return inUseError.Message
+}
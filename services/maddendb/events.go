@@ -0,0 +1,55 @@
+package maddendb
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+//defines the change event log streamed to live clients, every instance of the service reads the same log
+
+const (
+	//announces each summary created, including one repeating the current summary
+	EVENT_SUMMARY_CREATED = "summary.created"
+	//notified with the id of each change event once the transaction writing it commits
+	CHANGE_EVENT_CHANNEL = "madden_change_events"
+	//transaction level advisory lock held while writing a change event, it makes ids commit in order
+	//so a reader that has seen an id never later finds a smaller one
+	CHANGE_EVENT_LOCK = 7470001
+)
+
+//changeEvent builds the change event announcing payload
+func changeEvent(payload WebhookPayload) (ChangeEvent, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return ChangeEvent{}, &DbError{Message: "error encoding change event", OriginalError: err}
+	}
+	return ChangeEvent{Event: payload.Event, Payload: string(encoded), CreatedAt: payload.OccurredAt}, nil
+}
+
+//summaryCreatedPayload builds the payload announcing the creation of summary
+func summaryCreatedPayload(summary Summary) WebhookPayload {
+	payload := summaryPayload(summary)
+	payload.Event = EVENT_SUMMARY_CREATED
+	return payload
+}
+
+//recordChangeEvent appends payload to the change event log within tx, listeners are notified once tx commits
+func recordChangeEvent(tx *gorm.DB, payload WebhookPayload) error {
+	event, err := changeEvent(payload)
+	if err != nil {
+		return err
+	}
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", CHANGE_EVENT_LOCK).Error; err != nil {
+		return &DbError{Message: "error while ordering change events", OriginalError: err}
+	}
+	if err := tx.Create(&event).Error; err != nil {
+		return &DbError{Message: "error while recording change event", OriginalError: err}
+	}
+	//notifications are only delivered if tx commits
+	if err := tx.Exec("SELECT pg_notify(?, ?)", CHANGE_EVENT_CHANNEL, strconv.FormatUint(uint64(event.ID), 10)).Error; err != nil {
+		return &DbError{Message: "error while notifying change event", OriginalError: err}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ go 1.16
 
 require (
 	github.com/go-playground/assert/v2 v2.0.1
+	github.com/jackc/pgx/v4 v4.16.1
 	github.com/jinzhu/now v1.1.5 // indirect
 	golang.org/x/crypto v0.0.0-20220525230936-793ad666bf5e // indirect
 	gorm.io/driver/postgres v1.3.7
@@ -0,0 +1,10 @@
+package maddendb
+
+//defines the background jobs shared by every instance of the service
+
+const (
+	//marks ended items historical
+	JOB_HISTORICIZE = "historicize"
+	//transaction level advisory lock held by the one instance running a historicize, other instances skip the run
+	HISTORICIZE_LOCK = 7470002
+)
@@ -1,54 +1,176 @@
 package maddendb
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v4/stdlib"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 //defines and implements madden crud operations
 
 type SortField int
 
+//postgres error code raised when a unique constraint or index is violated
+const uniqueViolation = "23505"
+
+//errImportRolledBack is returned within an import transaction that must not be committed
+var errImportRolledBack = errors.New("import rolled back")
+
 const (
 	StartDate SortField = 0
 	EndDate   SortField = 1
 )
 
+//PurgeResult counts the rows hard deleted by a trash purge
+type PurgeResult struct {
+	Items  int64
+	Images int64
+}
+
 //Madden defines an interface to interact with a madden information data store
 type Madden interface {
 	//GetSummary returns the most recent madden summary
-	GetSummary() (Summary, error)
+	GetSummary(ctx context.Context) (Summary, error)
 	//CreateSummary creates a new summary returning an error if something goes wrong
-	CreateSummary(summary Summary) (Summary, error)
+	CreateSummary(ctx context.Context, summary Summary) (Summary, error)
 	//GetPublished returns current state of if madden is published
-	GetPublished() (Published, error)
+	GetPublished(ctx context.Context) (Published, error)
 	//CreatePublished creates a new state of madden published
-	CreatePublished(published Published) (Published, error)
+	CreatePublished(ctx context.Context, published Published) (Published, error)
 	//CreateMaintenacneItem creates a new madden item returning an error if anything fails, or if an identical item exists
-	CreateMaddenItem(item MaddenItem) (MaddenItem, error)
+	//a revision attributed to actor is recorded with the item
+	//conflicts with other items are checked within the write after locking the images booked, so concurrent writes booking an image are checked in turn
+	//with strict an OverlapError is returned if there are any, otherwise they are listed in Conflicts of the created item
+	CreateMaddenItem(ctx context.Context, item MaddenItem, strict bool, actor string) (MaddenItem, error)
 	//DeleteMaddenItem deletes a madden item given an id, returning an error if one occurs
-	DeleteMaddenItem(id uint) error
+	//a revision attributed to actor is recorded if the item existed
+	DeleteMaddenItem(ctx context.Context, id uint, actor string) error
 	//UpdateMaddenItem updates an existing madden item returning an error if anything fails, or if the item did not already exist
-	UpdateMaddenItem(item MaddenItem) (MaddenItem, error)
+	//a VersionConflictError is returned if the stored version is not expectedVersion, a revision attributed to actor is recorded with the update
+	//conflicts are checked as by CreateMaddenItem
+	UpdateMaddenItem(ctx context.Context, item MaddenItem, expectedVersion uint, strict bool, actor string) (MaddenItem, error)
+	//GetMaddenItemRevisions returns every revision of the madden item with id oldest first, revisions remain after the item is deleted
+	GetMaddenItemRevisions(ctx context.Context, id uint) ([]ItemRevision, error)
+	//GetMaddenItemRevision returns a single revision of the madden item with id, or an error if it did not exist
+	GetMaddenItemRevision(ctx context.Context, id, revision uint) (ItemRevision, error)
 	//GetMaddenItems returns a page of madden items offest by pagenum and size, filtered on start and end date, and sorted by sortField returning an error if anything goes wrong
-	//pages are 0 indexed
-	GetMaddenItems(pageNum, size int, startDate, endDate int64, sortField SortField, historic bool) ([]MaddenItem, error)
+	//pages are 0 indexed, recurring items are listed as each of their occurrences within the dates, marked by OccurrenceStart
+	GetMaddenItems(ctx context.Context, pageNum, size int, startDate, endDate int64, sortField SortField, historic bool) ([]MaddenItem, error)
+	//GetMaddenItemsAfter returns up to size madden items following cursor, filtered and sorted the same way as GetMaddenItems
+	//a nil cursor returns the first page, the cursor must have been built with the same sortField
+	GetMaddenItemsAfter(ctx context.Context, cursor *ItemCursor, size int, startDate, endDate int64, sortField SortField, historic bool) ([]MaddenItem, error)
+	//SearchMaddenItems returns a page of madden items matching every term of query, filtered and expanded into occurrences the same way as GetMaddenItems
+	//results are ordered by rank, best match first, see ParseSearchQuery for the query syntax
+	SearchMaddenItems(ctx context.Context, query string, pageNum, size int, startDate, endDate int64, historic bool) ([]SearchResult, error)
 	//GetMaddenItemById returns the madden item with the passed id, or an error if it did not exist or something went wrong
-	GetMaddenItemById(id uint) (MaddenItem, error)
-	//CreateImage creates a new madden image returning an error if anything fails or an image with the same name exists
-	CreateMaddenImage(image MaddenImageFile) (MaddenImageFile, error)
+	GetMaddenItemById(ctx context.Context, id uint) (MaddenItem, error)
+	//GetMaddenItemsLastModified returns the latest time any item was created, updated, deleted or restored, zero if there are no items
+	//a change to any item may move it into or out of a page, so this bounds the last change to every page of items
+	GetMaddenItemsLastModified(ctx context.Context) (time.Time, error)
+	//SetMaddenItemOccurrence stores occurrence as the edited or cancelled occurrence of the recurring item with id starting at occurrence.OccurrenceStart
+	//the rest of the series is unchanged, an error is returned if the item has no such occurrence
+	//a VersionConflictError is returned if the stored version is not expectedVersion, a revision attributed to actor is recorded with the change
+	//conflicts of an edited occurrence are checked as by CreateMaddenItem
+	SetMaddenItemOccurrence(ctx context.Context, id uint, occurrence ItemOccurrence, expectedVersion uint, strict bool, actor string) (MaddenItem, error)
+	//FindMaddenItemConflicts returns the conflicts item would have with other live items, historical or not, if it were written
+	//item is the first window of each conflict, recurring items are checked up to CONFLICT_HORIZON after they start with their stored exceptions
+	FindMaddenItemConflicts(ctx context.Context, item MaddenItem) ([]ItemConflict, error)
+	//GetMaddenItemConflicts returns every conflict between windows of live items, historical or not, that start before to and end after from
+	GetMaddenItemConflicts(ctx context.Context, from, to int64) ([]ItemConflict, error)
+	//GetMaddenStatus returns the status of every live image at the unix time at, given by the live items active then, historical or not
+	GetMaddenStatus(ctx context.Context, at int64) (MaddenStatus, error)
+	//GetMaddenAvailability returns the time every live image spent in each status over the periods between consecutive ascending boundaries
+	//statuses are given by live items, historical or not, the worst status booked for an image at a time winning
+	GetMaddenAvailability(ctx context.Context, boundaries []int64) ([]PeriodAvailability, error)
+	//CreateImage creates a new madden image returning an error if anything fails, or a ConflictError if an image with the same name exists
+	CreateMaddenImage(ctx context.Context, image MaddenImageFile) (MaddenImageFile, error)
+	//FindOrCreateMaddenImage returns the image whose ContentHash matches image, restoring it from the trash if it was deleted, or creates image if there is none
+	//the returned bool is true if image was created, image must have a ContentHash
+	FindOrCreateMaddenImage(ctx context.Context, image MaddenImageFile) (MaddenImageFile, bool, error)
 	//UpdateMaddenImage updates an existing madden image, returning an error if anything goes wrong or if the image did not exist
+	//a VersionConflictError is returned if the stored version is not expectedVersion
 	//the original maddenImageFile entity and the updated entity are returned
-	UpdateMaddenImage(image MaddenImageFile) (MaddenImageFile, MaddenImageFile, error)
+	UpdateMaddenImage(ctx context.Context, image MaddenImageFile, expectedVersion uint) (MaddenImageFile, MaddenImageFile, error)
 	//GetMaddenImages returns a page of of Madden images, offset by pagenum and size returning an error if anything goes wrong
-	GetMaddenImages(pageNum, size int) ([]MaddenImageFile, error)
+	GetMaddenImages(ctx context.Context, pageNum, size int) ([]MaddenImageFile, error)
+	//GetMaddenImagesAfter returns up to size madden images following cursor in creation order, a nil cursor returns the first page
+	GetMaddenImagesAfter(ctx context.Context, cursor *ImageCursor, size int) ([]MaddenImageFile, error)
 	//GetMaddenImagesByName returns a slice of madden images, offset by pagenum and size, with a name similar to, or exactly matching filename
-	GetMaddenImagesByName(pageNum, size int, filename string) ([]MaddenImageFile, error)
-	//DeleteMaddenImage deletes the image entry with id, returning an error if one occurs
-	DeleteMaddenImage(id uint) error
-	//SetupDatabase builds all table or migrates existing schemas, this should be the first call any client of this interface makes
-	SetupDatabase() error
+	GetMaddenImagesByName(ctx context.Context, pageNum, size int, filename string) ([]MaddenImageFile, error)
+	//DeleteMaddenImage deletes the image entry with id, returning an ImageInUseError listing the live items linking it unless cascade is set
+	//with cascade the image is first removed from each of those items, recording a revision attributed to actor for each
+	DeleteMaddenImage(ctx context.Context, id uint, cascade bool, actor string) error
+	//GetMaddenImageById returns the image with the passed id, or an error if it did not exist or something went wrong
+	GetMaddenImageById(ctx context.Context, id uint) (MaddenImageFile, error)
+	//GetAllMaddenImages returns every image including deleted images, ordered by id
+	GetAllMaddenImages(ctx context.Context) ([]MaddenImageFile, error)
+	//GetDanglingItemImages returns item images missing their item or image, or linking a live item to a deleted image, ordered by id
+	GetDanglingItemImages(ctx context.Context) ([]ItemImages, error)
+	//RemoveDanglingItemImages hard deletes the item images GetDanglingItemImages returns, returning them
+	//a revision attributed to actor is recorded for every live item changed
+	RemoveDanglingItemImages(ctx context.Context, actor string) ([]ItemImages, error)
+	//GetMaddenImageUsages returns every non deleted madden item linking the image with id, ordered by id
+	GetMaddenImageUsages(ctx context.Context, id uint) ([]MaddenItem, error)
+	//GetDeletedMaddenItems returns a page of soft deleted madden items, most recently deleted first
+	GetDeletedMaddenItems(ctx context.Context, pageNum, size int) ([]MaddenItem, error)
+	//GetDeletedMaddenImages returns a page of soft deleted madden images, most recently deleted first
+	GetDeletedMaddenImages(ctx context.Context, pageNum, size int) ([]MaddenImageFile, error)
+	//RestoreMaddenItem revives a soft deleted madden item along with any deleted images it links to
+	//a revision attributed to actor is recorded, an error is returned if the item was not in the trash
+	//conflicts of the restored item are checked as by CreateMaddenItem
+	RestoreMaddenItem(ctx context.Context, id uint, strict bool, actor string) (MaddenItem, error)
+	//PurgeDeleted hard deletes madden items deleted before cutoff and their image links, then images deleted before cutoff no item links to
+	//item revisions are kept
+	PurgeDeleted(ctx context.Context, cutoff time.Time) (PurgeResult, error)
+	//GetMaddenItemsAfterId returns up to size non deleted madden items with an id greater than afterId, ordered by id
+	GetMaddenItemsAfterId(ctx context.Context, afterId uint, size int) ([]MaddenItem, error)
+	//ImportMadden applies records in order within a single transaction, counting each as created, updated or skipped
+	//images match stored images by file name and items match by the identical item rule of CreateMaddenItem, mode decides if matches are updated
+	//nothing is written if dryRun is set or any record fails, each failure is listed in the result, changes are attributed to actor
+	ImportMadden(ctx context.Context, records []ImportRecord, mode ImportMode, dryRun bool, actor string) (ImportResult, error)
+	//CreateWebhook creates a subscription to the events it names, every later change to an event it names queues a delivery to it
+	CreateWebhook(ctx context.Context, subscription WebhookSubscription) (WebhookSubscription, error)
+	//GetWebhooks returns every non deleted subscription ordered by id
+	GetWebhooks(ctx context.Context) ([]WebhookSubscription, error)
+	//GetWebhookById returns the subscription with id, or an error if it did not exist
+	GetWebhookById(ctx context.Context, id uint) (WebhookSubscription, error)
+	//DeleteWebhook deletes the subscription with id, its pending deliveries are no longer claimed
+	DeleteWebhook(ctx context.Context, id uint) error
+	//GetWebhookDeliveries returns a page of the deliveries to the subscription with id, most recent first, limited to status unless it is empty
+	GetWebhookDeliveries(ctx context.Context, id uint, status string, pageNum, size int) ([]WebhookDelivery, error)
+	//RedeliverWebhookDelivery makes the delivery with deliveryId to the subscription with id pending and due immediately with no attempts made
+	RedeliverWebhookDelivery(ctx context.Context, id, deliveryId uint) (WebhookDelivery, error)
+	//ClaimWebhookDeliveries returns up to size pending deliveries due at now to non deleted subscriptions, with their subscription, in due order
+	//claimed deliveries are not due again until lease has passed, so a delivery is only attempted by one claimant at a time
+	ClaimWebhookDeliveries(ctx context.Context, now time.Time, size int, lease time.Duration) ([]WebhookDelivery, error)
+	//CompleteWebhookDelivery records a successful attempt of the delivery with id made at deliveredAt
+	CompleteWebhookDelivery(ctx context.Context, id uint, deliveredAt time.Time) error
+	//FailWebhookDelivery records a failed attempt of the delivery with id, it is retried at retryAt or is dead if retryAt is nil
+	FailWebhookDelivery(ctx context.Context, id uint, message string, retryAt *time.Time) error
+	//GetLatestChangeEventId returns the id of the most recent change event, 0 if there are none
+	GetLatestChangeEventId(ctx context.Context) (uint, error)
+	//GetChangeEvents returns up to size change events with an id greater than afterId ordered by id
+	//ids increase in commit order, so every event after afterId committed by the time of the call is returned
+	GetChangeEvents(ctx context.Context, afterId uint, size int) ([]ChangeEvent, error)
+	//ListenChangeEvents sends on notify, without blocking, each time change events are committed by any instance sharing the data store
+	//one notification is sent as soon as listening has begun, it blocks until ctx is done or the listen fails
+	ListenChangeEvents(ctx context.Context, notify chan<- struct{}) error
+	//PurgeChangeEvents deletes change events created before cutoff returning the number deleted
+	PurgeChangeEvents(ctx context.Context, cutoff time.Time) (int64, error)
+	//HistoricizeMaddenItems marks every non deleted item that ended before cutoff and is not historical as historical, recording a revision attributed to actor for each
+	//only one caller across every instance sharing the data store runs at a time, a caller finding a run in progress returns false and changes nothing
+	//a completed run is recorded as the latest JobRun of JOB_HISTORICIZE and returned
+	HistoricizeMaddenItems(ctx context.Context, cutoff time.Time, actor string) (JobRun, bool, error)
+	//GetJobRun returns the latest run of the job with name, a zero JobRun if it has never run
+	GetJobRun(ctx context.Context, name string) (JobRun, error)
+	//SetupDatabase confirms the data store is ready for use, returning an error if its schema does not match this binary
+	//schemas are built and changed through a Migrator, this should be the first call any client of this interface makes
+	SetupDatabase(ctx context.Context) error
 }
 
 //postgres backed implementation of Madden
@@ -63,178 +185,1357 @@ func NewPostgresMaintenace(db *gorm.DB) Madden {
 
 //Interface implementation
 
-func (pm *postgresMadden) GetSummary() (Summary, error) {
+func (pm *postgresMadden) GetSummary(ctx context.Context) (Summary, error) {
 	summary := Summary{}
-	if err := pm.db.Order("created_at desc").Take(&summary).Error; err != nil {
+	if err := pm.db.WithContext(ctx).Order("created_at desc").Take(&summary).Error; err != nil {
+		if ctx.Err() != nil {
+			return summary, contextError(ctx.Err())
+		}
 		// There is currently summary, return an empty string
 		return summary, nil
 	}
 	return summary, nil
 }
 
-func (pm *postgresMadden) CreateSummary(summary Summary) (Summary, error) {
+func (pm *postgresMadden) CreateSummary(ctx context.Context, summary Summary) (Summary, error) {
 	created := summary
-	if err := pm.db.Create(&created).Error; err != nil {
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		created, err = insertSummary(tx, summary)
+		return err
+	})
+	if err != nil {
 		fmt.Printf("error creating summary ERROR: %s\n", err.Error())
 		return summary, &DbError{Message: "error creating summary", OriginalError: err}
 	}
 	return created, nil
 }
 
-func (pm *postgresMadden) GetPublished() (Published, error) {
+func (pm *postgresMadden) GetPublished(ctx context.Context) (Published, error) {
 	published := Published{}
-	if err := pm.db.Order("created_at desc").Take(&published).Error; err != nil {
+	if err := pm.db.WithContext(ctx).Order("created_at desc").Take(&published).Error; err != nil {
+		if ctx.Err() != nil {
+			return published, contextError(ctx.Err())
+		}
 		// There is currently no published state
 		return published, nil
 	}
 	return published, nil
 }
 
-func (pm *postgresMadden) CreatePublished(published Published) (Published, error) {
+func (pm *postgresMadden) CreatePublished(ctx context.Context, published Published) (Published, error) {
 	created := published
-	if err := pm.db.Create(&created).Error; err != nil {
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		created, err = insertPublished(tx, published)
+		return err
+	})
+	if err != nil {
 		fmt.Printf("error creating published state ERROR: %s\n", err.Error())
 		return published, &DbError{Message: "error creating published state", OriginalError: err}
 	}
 	return created, nil
 }
 
-func (pm *postgresMadden) DeleteMaddenImage(id uint) error {
-	image := MaddenImageFile{Model: gorm.Model{ID: id}}
-	if err := pm.db.Delete(&image).Error; err != nil {
-		fmt.Printf("error deleting entry with id %d, ERROR: %s\n", id, err.Error())
-		return &DbError{Message: fmt.Sprintf("error deleting entry %d", id), OriginalError: err}
+func (pm *postgresMadden) DeleteMaddenImage(ctx context.Context, id uint, cascade bool, actor string) error {
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		image := MaddenImageFile{}
+		//the row lock holds off item writes linking the image until the delete commits, they then find it deleted
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Take(&image, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				//nothing to delete
+				return nil
+			}
+			return err
+		}
+		itemIds := []uint{}
+		linked := tx.Model(&ItemImages{}).Select("madden_item_id").Where("madden_image_file_id = ?", id)
+		if err := tx.Model(&MaddenItem{}).Where("id IN (?)", linked).Order("id asc").Pluck("id", &itemIds).Error; err != nil {
+			return err
+		}
+		if len(itemIds) > 0 && !cascade {
+			return imageInUseError(id, itemIds)
+		}
+		for _, itemId := range itemIds {
+			if err := tx.Unscoped().Where("madden_item_id = ? AND madden_image_file_id = ?", itemId, id).Delete(&ItemImages{}).Error; err != nil {
+				return err
+			}
+			if err := reviseItemImages(tx, itemId, actor); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&image).Error
+	})
+	if err != nil {
+		if inUse, ok := err.(*ImageInUseError); ok {
+			return inUse
+		}
+		fmt.Printf("error deleting image with id %d, ERROR: %s\n", id, err.Error())
+		return &DbError{Message: fmt.Sprintf("error deleting image %d", id), OriginalError: err}
 	}
 	return nil
 }
 
-func (pm *postgresMadden) SetupDatabase() error {
-	if err := pm.db.AutoMigrate(&MaddenImageFile{}, &MaddenItem{}, &ItemImages{}, &Summary{}, &Published{}); err != nil {
-		return &DbError{Message: "Error building database", OriginalError: err}
+func (pm *postgresMadden) GetMaddenImageById(ctx context.Context, id uint) (MaddenImageFile, error) {
+	image := MaddenImageFile{}
+	if err := pm.db.WithContext(ctx).Take(&image, id).Error; err != nil {
+		return image, &DbError{Message: fmt.Sprintf("image with ID: %d did not exist", id), OriginalError: err}
 	}
-	return nil
+	return image, nil
 }
 
-func (pm *postgresMadden) CreateMaddenItem(item MaddenItem) (MaddenItem, error) {
-	if existed, err := pm.itemExists(item); err != nil || existed {
-		return MaddenItem{}, &DbError{Message: "Item Already existed", OriginalError: err}
-	}
-	//insert the madden item
-	insertable := item
-	if err := pm.db.Create(&insertable).Error; err != nil {
-		return MaddenItem{}, &DbError{Message: "error during Item Creation", OriginalError: err}
+func (pm *postgresMadden) GetAllMaddenImages(ctx context.Context) ([]MaddenImageFile, error) {
+	images := []MaddenImageFile{}
+	if err := pm.db.WithContext(ctx).Unscoped().Order("id asc").Find(&images).Error; err != nil {
+		return nil, &DbError{Message: "error while listing images", OriginalError: err}
 	}
-	if err := pm.db.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Find(&insertable).Error; err != nil {
-		return insertable, &DbError{Message: "error while retrieving created item", OriginalError: err}
+	return images, nil
+}
+
+func (pm *postgresMadden) GetDanglingItemImages(ctx context.Context) ([]ItemImages, error) {
+	dangling, err := findDanglingItemImages(pm.db.WithContext(ctx))
+	if err != nil {
+		return nil, &DbError{Message: "error while searching for dangling item images", OriginalError: err}
 	}
-	return insertable, nil
+	return dangling, nil
 }
 
-func (pm *postgresMadden) UpdateMaddenItem(item MaddenItem) (MaddenItem, error) {
-	//error is nil if the item existed
-	if err := pm.db.Take(&MaddenItem{}, item.ID).Error; err != nil {
-		return MaddenItem{}, &DbError{Message: "Error or item did not exist on update", OriginalError: err}
+func (pm *postgresMadden) RemoveDanglingItemImages(ctx context.Context, actor string) ([]ItemImages, error) {
+	removed := []ItemImages{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dangling, err := findDanglingItemImages(tx.Clauses(clause.Locking{Strength: "UPDATE"}))
+		if err != nil || len(dangling) == 0 {
+			return err
+		}
+		ids := []uint{}
+		for _, itemImage := range dangling {
+			ids = append(ids, itemImage.ID)
+		}
+		if err := tx.Unscoped().Where("id IN ?", ids).Delete(&ItemImages{}).Error; err != nil {
+			return err
+		}
+		revised := map[uint]bool{}
+		for _, itemImage := range dangling {
+			if itemImage.MaddenItemId == 0 || revised[itemImage.MaddenItemId] {
+				continue
+			}
+			revised[itemImage.MaddenItemId] = true
+			if err := reviseItemImages(tx, itemImage.MaddenItemId, actor); err != nil {
+				return err
+			}
+		}
+		removed = dangling
+		return nil
+	})
+	if err != nil {
+		return nil, &DbError{Message: "error while removing dangling item images", OriginalError: err}
 	}
-	insertable := item
-	mapped := entryToMap(insertable)
-	if err := pm.db.Model(&insertable).Updates(mapped).Error; err != nil {
-		return MaddenItem{}, &DbError{Message: "error on update", OriginalError: err}
+	return removed, nil
+}
+
+func (pm *postgresMadden) SetupDatabase(ctx context.Context) error {
+	migrator, err := NewPostgresMigrator(pm.db.WithContext(ctx))
+	if err != nil {
+		return &DbError{Message: "Error loading migrations", OriginalError: err}
 	}
-	if err := pm.db.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Find(&insertable).Error; err != nil {
-		return insertable, &DbError{Message: "error while retrieving updated item", OriginalError: err}
+	return migrator.CheckSchemaVersion()
+}
+
+func (pm *postgresMadden) CreateMaddenItem(ctx context.Context, item MaddenItem, strict bool, actor string) (MaddenItem, error) {
+	created := MaddenItem{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if created, err = insertItem(tx, item, actor); err != nil {
+			return err
+		}
+		created.Conflicts, err = checkItemConflicts(tx, created, strict)
+		return err
+	})
+	if err != nil {
+		return MaddenItem{}, pm.itemWriteError(ctx, item, "error during Item Creation", unwrittenOverlap(err, item.ID))
 	}
+	return created, nil
+}
 
-	return insertable, nil
+func (pm *postgresMadden) UpdateMaddenItem(ctx context.Context, item MaddenItem, expectedVersion uint, strict bool, actor string) (MaddenItem, error) {
+	updated := MaddenItem{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if updated, err = updateItem(tx, item, expectedVersion, actor); err != nil {
+			return err
+		}
+		updated.Conflicts, err = checkItemConflicts(tx, updated, strict)
+		return err
+	})
+	if err != nil {
+		return MaddenItem{}, pm.itemWriteError(ctx, item, "error on update", err)
+	}
+	return updated, nil
 }
 
-func (pm *postgresMadden) DeleteMaddenItem(id uint) error {
-	item := MaddenItem{Model: gorm.Model{ID: id}}
-	if err := pm.db.Delete(&item).Error; err != nil {
+func (pm *postgresMadden) DeleteMaddenItem(ctx context.Context, id uint, actor string) error {
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		item := MaddenItem{}
+		if err := tx.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Take(&item, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				//nothing to delete
+				return nil
+			}
+			return err
+		}
+		if err := tx.Delete(&item).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Take(&item, id).Error; err != nil {
+			return err
+		}
+		return recordRevision(tx, item, REVISION_DELETE, actor)
+	})
+	if err != nil {
 		fmt.Printf("error deleting entry with id %d, ERROR: %s\n", id, err.Error())
 		return &DbError{Message: fmt.Sprintf("error deleting entry %d", id), OriginalError: err}
 	}
 	return nil
 }
 
-func (pm *postgresMadden) GetMaddenItems(pageNum, size int, startDate, endDate int64, sortField SortField, historic bool) ([]MaddenItem, error) {
+func (pm *postgresMadden) GetMaddenItems(ctx context.Context, pageNum, size int, startDate, endDate int64, sortField SortField, historic bool) ([]MaddenItem, error) {
+	//every page up to this one may be filled by occurrences
+	occurrences, err := pm.findOccurrences(ctx, startDate, endDate, historic, func(MaddenItem) bool { return true }, (pageNum+1)*size)
+	if err != nil {
+		return nil, err
+	}
 	items := []MaddenItem{}
-	if err := pm.db.Offset(pageNum*size).Limit(size).Order(itemOrderString(sortField, startDate, endDate, false)).Order(itemOrderString(sortField, startDate, endDate, true)).Where("begin_date < ? AND end_date > ? AND is_historical = ?", startDate, endDate, historic).Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Find(&items).Error; err != nil {
+	query := pm.db.WithContext(ctx).Order(itemOrderString(sortField, startDate, endDate, false)).Order(itemOrderString(sortField, startDate, endDate, true)).Order("id asc").Where("begin_date < ? AND end_date > ? AND is_historical = ? AND rrule = ''", startDate, endDate, historic)
+	if len(occurrences) == 0 {
+		query = query.Offset(pageNum * size).Limit(size)
+	} else {
+		query = query.Limit((pageNum + 1) * size)
+	}
+	if err := query.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Find(&items).Error; err != nil {
 		fmt.Println(err.Error())
 		return nil, &DbError{Message: "error on search", OriginalError: err}
 	}
-	return items, nil
+	if len(occurrences) == 0 {
+		return items, nil
+	}
+	merged := mergeItems(items, occurrences, sortField)
+	start, end := pageBounds(len(merged), pageNum, size)
+	return merged[start:end], nil
 }
 
-func (pm *postgresMadden) GetMaddenItemById(id uint) (MaddenItem, error) {
+func (pm *postgresMadden) GetMaddenItemsAfter(ctx context.Context, cursor *ItemCursor, size int, startDate, endDate int64, sortField SortField, historic bool) ([]MaddenItem, error) {
+	occurrences, err := pm.findOccurrences(ctx, startDate, endDate, historic, func(occurrence MaddenItem) bool { return cursor == nil || cursor.precedes(occurrence) }, size)
+	if err != nil {
+		return nil, err
+	}
+	items := []MaddenItem{}
+	query := pm.db.WithContext(ctx).Limit(size).Order(itemOrderString(sortField, startDate, endDate, false)).Order(itemOrderString(sortField, startDate, endDate, true)).Order("id asc").Where("begin_date < ? AND end_date > ? AND is_historical = ? AND rrule = ''", startDate, endDate, historic)
+	if cursor != nil {
+		query = query.Where(itemKeysetString(sortField), cursor.keyset()...)
+	}
+	if err := query.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Find(&items).Error; err != nil {
+		return nil, &DbError{Message: "error on search", OriginalError: err}
+	}
+	if len(occurrences) == 0 {
+		return items, nil
+	}
+	merged := mergeItems(items, occurrences, sortField)
+	_, end := pageBounds(len(merged), 0, size)
+	return merged[:end], nil
+}
+
+func (pm *postgresMadden) FindMaddenItemConflicts(ctx context.Context, item MaddenItem) ([]ItemConflict, error) {
+	imageIds := itemImageIds(item)
+	if len(imageIds) == 0 {
+		return []ItemConflict{}, nil
+	}
+	if item.ID != 0 && item.RRule != "" {
+		stored := []ItemOccurrence{}
+		if err := pm.db.WithContext(ctx).Where("madden_item_id = ?", item.ID).Order("occurrence_start asc").Find(&stored).Error; err != nil {
+			return nil, &DbError{Message: "error on conflict search", OriginalError: err}
+		}
+		item.Occurrences = keptOccurrences(item, stored)
+	}
+	candidates, from, to, err := candidateWindows(item)
+	if err != nil {
+		return nil, err
+	}
+	others, err := findWindows(pm.db.WithContext(ctx), from, to, imageIds)
+	if err != nil {
+		return nil, err
+	}
+	return candidateConflicts(candidates, others), nil
+}
+
+func (pm *postgresMadden) GetMaddenItemConflicts(ctx context.Context, from, to int64) ([]ItemConflict, error) {
+	windows, err := findWindows(pm.db.WithContext(ctx), from, to, nil)
+	if err != nil {
+		return nil, err
+	}
+	return windowConflicts(windows), nil
+}
+
+func (pm *postgresMadden) GetMaddenStatus(ctx context.Context, at int64) (MaddenStatus, error) {
+	images := []MaddenImageFile{}
+	if err := pm.db.WithContext(ctx).Order("id asc").Find(&images).Error; err != nil {
+		return MaddenStatus{}, &DbError{Message: "error on status search", OriginalError: err}
+	}
+	//windows starting at or before at and ending after it
+	windows, err := findWindows(pm.db.WithContext(ctx), at, at+1, nil)
+	if err != nil {
+		return MaddenStatus{}, err
+	}
+	return buildStatus(at, images, windows), nil
+}
+
+func (pm *postgresMadden) GetMaddenAvailability(ctx context.Context, boundaries []int64) ([]PeriodAvailability, error) {
+	if err := availabilityBoundariesValid(boundaries); err != nil {
+		return nil, err
+	}
+	images := []MaddenImageFile{}
+	if err := pm.db.WithContext(ctx).Order("id asc").Find(&images).Error; err != nil {
+		return nil, &DbError{Message: "error on availability search", OriginalError: err}
+	}
+	windows, err := findWindows(pm.db.WithContext(ctx), boundaries[0], boundaries[len(boundaries)-1], nil)
+	if err != nil {
+		return nil, err
+	}
+	return buildAvailability(boundaries, images, windows), nil
+}
+
+func (pm *postgresMadden) SearchMaddenItems(ctx context.Context, query string, pageNum, size int, startDate, endDate int64, historic bool) ([]SearchResult, error) {
+	terms, err := ParseSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	//every page up to this one may be filled by occurrences
+	occurrences, err := pm.searchOccurrences(ctx, terms, startDate, endDate, historic, (pageNum+1)*size)
+	if err != nil {
+		return nil, err
+	}
+	rows := []searchRow{}
+	search := pm.db.WithContext(ctx).Model(&MaddenItem{}).Select("madden_items.id, "+searchSelection("search_vector", "summary", "details")).Joins("CROSS JOIN to_tsquery(?, ?) query", searchConfig, tsQuery(terms)).Where("search_vector @@ query").Where("begin_date < ? AND end_date > ? AND is_historical = ? AND rrule = ''", startDate, endDate, historic).Order("rank desc").Order("madden_items.id asc")
+	if len(occurrences) == 0 {
+		search = search.Offset(pageNum * size).Limit(size)
+	} else {
+		search = search.Limit((pageNum + 1) * size)
+	}
+	if err := search.Scan(&rows).Error; err != nil {
+		return nil, &DbError{Message: "error on search", OriginalError: err}
+	}
+	ids := []uint{}
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	items := []MaddenItem{}
+	if len(ids) > 0 {
+		if err := pm.db.WithContext(ctx).Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&items, ids).Error; err != nil {
+			return nil, &DbError{Message: "error on search", OriginalError: err}
+		}
+	}
+	byId := map[uint]MaddenItem{}
+	for _, item := range items {
+		byId[item.ID] = item
+	}
+	results := []SearchResult{}
+	for _, row := range rows {
+		results = append(results, SearchResult{Item: byId[row.ID], Rank: row.Rank, SummarySnippet: row.SummarySnippet, DetailsSnippet: row.DetailsSnippet})
+	}
+	if len(occurrences) == 0 {
+		return results, nil
+	}
+	results = append(results, occurrences...)
+	sortSearchResults(results)
+	start, end := pageBounds(len(results), pageNum, size)
+	return results[start:end], nil
+}
+
+func (pm *postgresMadden) GetMaddenItemById(ctx context.Context, id uint) (MaddenItem, error) {
 	item := MaddenItem{}
-	if err := pm.db.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").First(&item, id).Error; err != nil {
+	if err := pm.db.WithContext(ctx).Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").First(&item, id).Error; err != nil {
 		//some error other than the record didn't exist
 		if !(err == gorm.ErrRecordNotFound) {
 			return MaddenItem{}, &DbError{Message: err.Error(), OriginalError: err}
 		}
-		return MaddenItem{}, &DbError{Message: fmt.Sprintf("item with ID: %d did not exist", item.ID)}
+		return MaddenItem{}, &DbError{Message: fmt.Sprintf("item with ID: %d did not exist", id), OriginalError: err}
 	}
 	return item, nil
 }
 
-func (pm *postgresMadden) CreateMaddenImage(image MaddenImageFile) (MaddenImageFile, error) {
+func (pm *postgresMadden) GetMaddenItemsLastModified(ctx context.Context) (time.Time, error) {
+	var latest *time.Time
+	//greatest ignores the deleted_at of live items
+	if err := pm.db.WithContext(ctx).Unscoped().Model(&MaddenItem{}).Select("max(greatest(updated_at, deleted_at))").Scan(&latest).Error; err != nil {
+		return time.Time{}, &DbError{Message: "error while reading the latest item change", OriginalError: err}
+	}
+	if latest == nil {
+		return time.Time{}, nil
+	}
+	return *latest, nil
+}
+
+func (pm *postgresMadden) SetMaddenItemOccurrence(ctx context.Context, id uint, occurrence ItemOccurrence, expectedVersion uint, strict bool, actor string) (MaddenItem, error) {
+	updated := MaddenItem{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stored := MaddenItem{}
+		if err := tx.Preload("ItemImages").Preload("Occurrences").Take(&stored, id).Error; err != nil {
+			return &DbError{Message: fmt.Sprintf("item with ID: %d did not exist", id), OriginalError: err}
+		}
+		if stored.Version != expectedVersion {
+			return staleVersionError("item", id, stored.Version)
+		}
+		if err := checkOccurrence(stored, occurrence.OccurrenceStart); err != nil {
+			return err
+		}
+		if err := lockImages(tx, itemImageIds(stored)); err != nil {
+			return err
+		}
+		occurrence.ID = 0
+		occurrence.MaddenItemId = id
+		for _, exception := range stored.Occurrences {
+			if exception.OccurrenceStart == occurrence.OccurrenceStart {
+				occurrence.ID = exception.ID
+				occurrence.CreatedAt = exception.CreatedAt
+			}
+		}
+		//saved in full so clearing a cancellation or a field is stored too
+		if err := tx.Save(&occurrence).Error; err != nil {
+			return err
+		}
+		stored.Occurrences = setOccurrence(stored.Occurrences, occurrence)
+		//the version condition catches a concurrent update committed since the item was read
+		revised := tx.Model(&MaddenItem{}).Where("id = ? AND version = ?", id, expectedVersion).UpdateColumns(map[string]interface{}{"version": gorm.Expr("version + 1"), "updated_at": time.Now(), "series_end": seriesEnd(stored)})
+		if revised.Error != nil {
+			return revised.Error
+		}
+		if revised.RowsAffected == 0 {
+			return currentVersionError(tx, &MaddenItem{}, "item", id)
+		}
+		if err := tx.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Take(&updated, id).Error; err != nil {
+			return &DbError{Message: "error while retrieving updated item", OriginalError: err}
+		}
+		if err := recordRevision(tx, updated, REVISION_UPDATE, actor); err != nil {
+			return err
+		}
+		var err error
+		updated.Conflicts, err = writeConflicts(occurrenceWindows(updated, occurrence), strict, windowFinder(tx))
+		return err
+	})
+	if err != nil {
+		return MaddenItem{}, pm.itemWriteError(ctx, updated, fmt.Sprintf("error updating occurrence of item %d", id), err)
+	}
+	return updated, nil
+}
+
+func (pm *postgresMadden) GetMaddenItemRevisions(ctx context.Context, id uint) ([]ItemRevision, error) {
+	revisions := []ItemRevision{}
+	if err := pm.db.WithContext(ctx).Where("madden_item_id = ?", id).Order("revision asc").Find(&revisions).Error; err != nil {
+		return nil, &DbError{Message: "error while searching for revisions", OriginalError: err}
+	}
+	if len(revisions) == 0 {
+		return nil, &DbError{Message: fmt.Sprintf("item with ID: %d has no history", id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	return revisions, nil
+}
+
+func (pm *postgresMadden) GetMaddenItemRevision(ctx context.Context, id, revision uint) (ItemRevision, error) {
+	found := ItemRevision{}
+	if err := pm.db.WithContext(ctx).Where("madden_item_id = ? AND revision = ?", id, revision).Take(&found).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return found, &DbError{Message: fmt.Sprintf("revision %d of item %d did not exist", revision, id), OriginalError: err}
+		}
+		return found, &DbError{Message: "error while searching for revision", OriginalError: err}
+	}
+	return found, nil
+}
+
+func (pm *postgresMadden) CreateMaddenImage(ctx context.Context, image MaddenImageFile) (MaddenImageFile, error) {
 	inserted := image
-	if err := pm.db.Where("file_name=?", image.FileName).Take(&MaddenImageFile{}).Error; err != nil {
+	existing := MaddenImageFile{}
+	if err := pm.db.WithContext(ctx).Where("file_name=?", image.FileName).Take(&existing).Error; err != nil {
 		if !(err == gorm.ErrRecordNotFound) {
 			return inserted, &DbError{Message: "error during check for existing image", OriginalError: err}
 		}
 	} else {
-		return inserted, &DbError{Message: fmt.Sprintf("image with filename %s already exists", image.FileName)}
+		return inserted, duplicateImageError(existing)
 	}
-	if err := pm.db.Create(&inserted).Error; err != nil {
+	if err := pm.db.WithContext(ctx).Create(&inserted).Error; err != nil {
 		return inserted, &DbError{Message: "error while inserting image into database", OriginalError: err}
 	}
 	return inserted, nil
 }
 
-func (pm *postgresMadden) UpdateMaddenImage(image MaddenImageFile) (MaddenImageFile, MaddenImageFile, error) {
+func (pm *postgresMadden) FindOrCreateMaddenImage(ctx context.Context, image MaddenImageFile) (MaddenImageFile, bool, error) {
+	if image.ContentHash == "" {
+		return image, false, &DbError{Message: "image content hash is required"}
+	}
+	if existing, found, err := pm.findImageByContent(ctx, image.ContentHash); err != nil || found {
+		return existing, false, err
+	}
+	created, err := pm.CreateMaddenImage(ctx, image)
+	if err != nil {
+		var conflict *ConflictError
+		var violation interface{ SQLState() string }
+		if errors.As(err, &conflict) || (errors.As(err, &violation) && violation.SQLState() == uniqueViolation) {
+			//a concurrent create of the same content committed first
+			if existing, found, findErr := pm.findImageByContent(ctx, image.ContentHash); findErr == nil && found {
+				return existing, false, nil
+			}
+		}
+		return created, false, err
+	}
+	return created, true, nil
+}
+
+func (pm *postgresMadden) UpdateMaddenImage(ctx context.Context, image MaddenImageFile, expectedVersion uint) (MaddenImageFile, MaddenImageFile, error) {
 	original := MaddenImageFile{}
 	updateable := image
-	if err := pm.db.Take(&original, image.ID).Error; err != nil {
+	if err := pm.db.WithContext(ctx).Take(&original, image.ID).Error; err != nil {
 		if !(err == gorm.ErrRecordNotFound) {
 			return original, updateable, &DbError{Message: "error during check for existing image", OriginalError: err}
 		}
-		return original, updateable, &DbError{Message: fmt.Sprintf("image with id %d did not exist", image.ID)}
+		return original, updateable, &DbError{Message: fmt.Sprintf("image with id %d did not exist", image.ID), OriginalError: err}
+	}
+	if original.Version != expectedVersion {
+		return original, updateable, staleVersionError("image", image.ID, original.Version)
+	}
+	updated := pm.db.WithContext(ctx).Model(&MaddenImageFile{}).Where("id = ? AND version = ?", image.ID, expectedVersion).Updates(imageToMap(image))
+	if updated.Error != nil {
+		return original, updateable, &DbError{Message: fmt.Sprintf("error while updating item with id %d", image.ID), OriginalError: updated.Error}
 	}
-	if err := pm.db.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Updates(updateable).Error; err != nil {
-		return original, updateable, &DbError{Message: fmt.Sprintf("error while updating item with id %d", image.ID), OriginalError: err}
+	if updated.RowsAffected == 0 {
+		return original, updateable, currentVersionError(pm.db.WithContext(ctx), &MaddenImageFile{}, "image", image.ID)
+	}
+	if err := pm.db.WithContext(ctx).Take(&updateable, image.ID).Error; err != nil {
+		return original, updateable, &DbError{Message: "error while retrieving updated image", OriginalError: err}
 	}
 	return original, updateable, nil
 }
 
-func (pm *postgresMadden) GetMaddenImages(pageNum, size int) ([]MaddenImageFile, error) {
+func (pm *postgresMadden) GetMaddenImageUsages(ctx context.Context, id uint) ([]MaddenItem, error) {
+	items := []MaddenItem{}
+	linked := pm.db.WithContext(ctx).Model(&ItemImages{}).Select("madden_item_id").Where("madden_image_file_id = ?", id)
+	if err := pm.db.WithContext(ctx).Where("id IN (?)", linked).Order("id asc").Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&items).Error; err != nil {
+		return nil, &DbError{Message: fmt.Sprintf("error while searching for usages of image %d", id), OriginalError: err}
+	}
+	return items, nil
+}
+
+func (pm *postgresMadden) GetMaddenImages(ctx context.Context, pageNum, size int) ([]MaddenImageFile, error) {
 	images := []MaddenImageFile{}
-	if err := pm.db.Offset(pageNum * size).Limit(size).Order(imageOrderString()).Find(&images).Error; err != nil {
+	if err := pm.db.WithContext(ctx).Offset(pageNum * size).Limit(size).Order(imageOrderString()).Order("id asc").Find(&images).Error; err != nil {
+		return images, &DbError{Message: "error while searching for images", OriginalError: err}
+	}
+	return images, nil
+}
+
+func (pm *postgresMadden) GetMaddenImagesAfter(ctx context.Context, cursor *ImageCursor, size int) ([]MaddenImageFile, error) {
+	images := []MaddenImageFile{}
+	query := pm.db.WithContext(ctx).Limit(size).Order(imageOrderString()).Order("id asc")
+	if cursor != nil {
+		query = query.Where("(created_at, id) > (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+	if err := query.Find(&images).Error; err != nil {
 		return images, &DbError{Message: "error while searching for images", OriginalError: err}
 	}
 	return images, nil
 }
 
-func (pm *postgresMadden) GetMaddenImagesByName(pageNum, size int, filename string) ([]MaddenImageFile, error) {
+func (pm *postgresMadden) GetMaddenImagesByName(ctx context.Context, pageNum, size int, filename string) ([]MaddenImageFile, error) {
 	images := []MaddenImageFile{}
-	if err := pm.db.Offset(pageNum*size).Limit(size).Where("file_name ~ ?", filename).Order(imageOrderString()).Find(&images).Error; err != nil {
+	if err := pm.db.WithContext(ctx).Offset(pageNum*size).Limit(size).Where("file_name ~ ?", filename).Order(imageOrderString()).Find(&images).Error; err != nil {
 		return images, &DbError{Message: "error while searching for images", OriginalError: err}
 	}
 
 	return images, nil
 }
 
+func (pm *postgresMadden) GetDeletedMaddenItems(ctx context.Context, pageNum, size int) ([]MaddenItem, error) {
+	items := []MaddenItem{}
+	if err := pm.db.WithContext(ctx).Unscoped().Offset(pageNum * size).Limit(size).Where("deleted_at IS NOT NULL").Order("deleted_at desc").Order("id desc").Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&items).Error; err != nil {
+		return nil, &DbError{Message: "error while searching for deleted items", OriginalError: err}
+	}
+	return items, nil
+}
+
+func (pm *postgresMadden) GetDeletedMaddenImages(ctx context.Context, pageNum, size int) ([]MaddenImageFile, error) {
+	images := []MaddenImageFile{}
+	if err := pm.db.WithContext(ctx).Unscoped().Offset(pageNum * size).Limit(size).Where("deleted_at IS NOT NULL").Order("deleted_at desc").Order("id desc").Find(&images).Error; err != nil {
+		return images, &DbError{Message: "error while searching for deleted images", OriginalError: err}
+	}
+	return images, nil
+}
+
+func (pm *postgresMadden) RestoreMaddenItem(ctx context.Context, id uint, strict bool, actor string) (MaddenItem, error) {
+	restored := MaddenItem{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("deleted_at IS NOT NULL").Take(&restored, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return &DbError{Message: fmt.Sprintf("item with ID: %d was not in the trash", id), OriginalError: err}
+			}
+			return &DbError{Message: "error while searching for deleted item", OriginalError: err}
+		}
+		if err := checkDuplicateItem(tx, restored); err != nil {
+			return err
+		}
+		linked := tx.Model(&ItemImages{}).Select("madden_image_file_id").Where("madden_item_id = ?", id)
+		if err := lockImages(tx, linked); err != nil {
+			return err
+		}
+		//update columns skips the BeforeUpdate hook, image links are kept as they were when the item was deleted and updated_at records the restore
+		if err := tx.Unscoped().Model(&restored).UpdateColumns(map[string]interface{}{"deleted_at": nil, "updated_at": time.Now()}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&MaddenImageFile{}).Where("id IN (?) AND deleted_at IS NOT NULL", linked).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return &DbError{Message: fmt.Sprintf("error restoring images of item %d", id), OriginalError: err}
+		}
+		if err := tx.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Take(&restored, id).Error; err != nil {
+			return &DbError{Message: "error while retrieving restored item", OriginalError: err}
+		}
+		if err := recordRevision(tx, restored, REVISION_RESTORE, actor); err != nil {
+			return err
+		}
+		var err error
+		restored.Conflicts, err = checkItemConflicts(tx, restored, strict)
+		return err
+	})
+	if err != nil {
+		return MaddenItem{}, pm.itemWriteError(ctx, restored, fmt.Sprintf("error restoring item %d", id), err)
+	}
+	return restored, nil
+}
+
+func (pm *postgresMadden) PurgeDeleted(ctx context.Context, cutoff time.Time) (PurgeResult, error) {
+	purged := PurgeResult{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		expired := tx.Unscoped().Model(&MaddenItem{}).Select("id").Where("deleted_at < ?", cutoff)
+		if err := tx.Unscoped().Where("madden_item_id IN (?)", expired).Delete(&ItemImages{}).Error; err != nil {
+			return err
+		}
+		items := tx.Unscoped().Where("deleted_at < ?", cutoff).Delete(&MaddenItem{})
+		if items.Error != nil {
+			return items.Error
+		}
+		purged.Items = items.RowsAffected
+		//images still linked to an item, deleted or not, are kept so the item can be restored intact
+		linked := tx.Unscoped().Model(&ItemImages{}).Select("madden_image_file_id").Where("madden_image_file_id IS NOT NULL")
+		images := tx.Unscoped().Where("deleted_at < ? AND id NOT IN (?)", cutoff, linked).Delete(&MaddenImageFile{})
+		if images.Error != nil {
+			return images.Error
+		}
+		purged.Images = images.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return PurgeResult{}, &DbError{Message: "error purging deleted items", OriginalError: err}
+	}
+	return purged, nil
+}
+
+func (pm *postgresMadden) GetMaddenItemsAfterId(ctx context.Context, afterId uint, size int) ([]MaddenItem, error) {
+	items := []MaddenItem{}
+	if err := pm.db.WithContext(ctx).Where("id > ?", afterId).Order("id asc").Limit(size).Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&items).Error; err != nil {
+		return nil, &DbError{Message: "error while listing items", OriginalError: err}
+	}
+	return items, nil
+}
+
+func (pm *postgresMadden) ImportMadden(ctx context.Context, records []ImportRecord, mode ImportMode, dryRun bool, actor string) (ImportResult, error) {
+	imp := newImporter(mode, actor)
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, record := range records {
+			outcome := importSkipped
+			//each record is applied under a savepoint so a failed record does not abort the rest of the import
+			err := tx.Transaction(func(savepoint *gorm.DB) error {
+				var err error
+				outcome, err = imp.apply(&pgImportStore{tx: savepoint}, record)
+				return err
+			})
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			imp.count(record.Line, outcome, err)
+		}
+		if dryRun || len(imp.result.Errors) > 0 {
+			return errImportRolledBack
+		}
+		return nil
+	})
+	if err != nil && err != errImportRolledBack {
+		if ctx.Err() != nil {
+			return ImportResult{}, contextError(ctx.Err())
+		}
+		return ImportResult{}, &DbError{Message: "error during import", OriginalError: err}
+	}
+	return imp.result, nil
+}
+
+func (pm *postgresMadden) CreateWebhook(ctx context.Context, subscription WebhookSubscription) (WebhookSubscription, error) {
+	created := subscription
+	if err := pm.db.WithContext(ctx).Create(&created).Error; err != nil {
+		fmt.Printf("error creating webhook ERROR: %s\n", err.Error())
+		return subscription, &DbError{Message: "error creating webhook", OriginalError: err}
+	}
+	return created, nil
+}
+
+func (pm *postgresMadden) GetWebhooks(ctx context.Context) ([]WebhookSubscription, error) {
+	subscriptions := []WebhookSubscription{}
+	if err := pm.db.WithContext(ctx).Order("id asc").Find(&subscriptions).Error; err != nil {
+		return nil, &DbError{Message: "error while listing webhooks", OriginalError: err}
+	}
+	return subscriptions, nil
+}
+
+func (pm *postgresMadden) GetWebhookById(ctx context.Context, id uint) (WebhookSubscription, error) {
+	subscription := WebhookSubscription{}
+	if err := pm.db.WithContext(ctx).Take(&subscription, id).Error; err != nil {
+		return subscription, &DbError{Message: fmt.Sprintf("webhook with ID: %d did not exist", id), OriginalError: err}
+	}
+	return subscription, nil
+}
+
+func (pm *postgresMadden) DeleteWebhook(ctx context.Context, id uint) error {
+	if err := pm.db.WithContext(ctx).Delete(&WebhookSubscription{}, id).Error; err != nil {
+		fmt.Printf("error deleting webhook with id %d, ERROR: %s\n", id, err.Error())
+		return &DbError{Message: fmt.Sprintf("error deleting webhook %d", id), OriginalError: err}
+	}
+	return nil
+}
+
+func (pm *postgresMadden) GetWebhookDeliveries(ctx context.Context, id uint, status string, pageNum, size int) ([]WebhookDelivery, error) {
+	deliveries := []WebhookDelivery{}
+	query := pm.db.WithContext(ctx).Where("webhook_subscription_id = ?", id)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("id desc").Offset(pageNum * size).Limit(size).Find(&deliveries).Error; err != nil {
+		return nil, &DbError{Message: "error while listing webhook deliveries", OriginalError: err}
+	}
+	return deliveries, nil
+}
+
+func (pm *postgresMadden) RedeliverWebhookDelivery(ctx context.Context, id, deliveryId uint) (WebhookDelivery, error) {
+	delivery := WebhookDelivery{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("webhook_subscription_id = ?", id).Take(&delivery, deliveryId).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&delivery).Updates(map[string]interface{}{"status": DELIVERY_PENDING, "attempts": 0, "next_attempt_at": time.Now(), "delivered_at": nil}).Error; err != nil {
+			return err
+		}
+		return tx.Take(&delivery, deliveryId).Error
+	})
+	if err != nil {
+		return WebhookDelivery{}, &DbError{Message: fmt.Sprintf("delivery %d of webhook %d could not be redelivered", deliveryId, id), OriginalError: err}
+	}
+	return delivery, nil
+}
+
+func (pm *postgresMadden) ClaimWebhookDeliveries(ctx context.Context, now time.Time, size int, lease time.Duration) ([]WebhookDelivery, error) {
+	deliveries := []WebhookDelivery{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		live := tx.Model(&WebhookSubscription{}).Select("id")
+		//rows claimed by a concurrent claimant are skipped rather than waited on
+		ids := []uint{}
+		if err := tx.Model(&WebhookDelivery{}).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).Where("status = ? AND next_attempt_at <= ? AND webhook_subscription_id IN (?)", DELIVERY_PENDING, now, live).Order("next_attempt_at asc").Order("id asc").Limit(size).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Model(&WebhookDelivery{}).Where("id IN ?", ids).Update("next_attempt_at", now.Add(lease)).Error; err != nil {
+			return err
+		}
+		return tx.Preload("WebhookSubscription").Where("id IN ?", ids).Order("id asc").Find(&deliveries).Error
+	})
+	if err != nil {
+		return nil, &DbError{Message: "error while claiming webhook deliveries", OriginalError: err}
+	}
+	return deliveries, nil
+}
+
+func (pm *postgresMadden) CompleteWebhookDelivery(ctx context.Context, id uint, deliveredAt time.Time) error {
+	err := pm.db.WithContext(ctx).Model(&WebhookDelivery{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":       DELIVERY_DELIVERED,
+		"attempts":     gorm.Expr("attempts + 1"),
+		"last_error":   "",
+		"delivered_at": deliveredAt,
+	}).Error
+	if err != nil {
+		return &DbError{Message: fmt.Sprintf("error recording delivery %d", id), OriginalError: err}
+	}
+	return nil
+}
+
+func (pm *postgresMadden) FailWebhookDelivery(ctx context.Context, id uint, message string, retryAt *time.Time) error {
+	updates := map[string]interface{}{"status": DELIVERY_DEAD, "attempts": gorm.Expr("attempts + 1"), "last_error": message}
+	if retryAt != nil {
+		updates["status"] = DELIVERY_PENDING
+		updates["next_attempt_at"] = *retryAt
+	}
+	if err := pm.db.WithContext(ctx).Model(&WebhookDelivery{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return &DbError{Message: fmt.Sprintf("error recording delivery %d", id), OriginalError: err}
+	}
+	return nil
+}
+
+func (pm *postgresMadden) GetLatestChangeEventId(ctx context.Context) (uint, error) {
+	latest := []uint{}
+	if err := pm.db.WithContext(ctx).Model(&ChangeEvent{}).Order("id desc").Limit(1).Pluck("id", &latest).Error; err != nil {
+		return 0, &DbError{Message: "error while reading the latest change event", OriginalError: err}
+	}
+	if len(latest) == 0 {
+		return 0, nil
+	}
+	return latest[0], nil
+}
+
+func (pm *postgresMadden) GetChangeEvents(ctx context.Context, afterId uint, size int) ([]ChangeEvent, error) {
+	events := []ChangeEvent{}
+	if err := pm.db.WithContext(ctx).Where("id > ?", afterId).Order("id asc").Limit(size).Find(&events).Error; err != nil {
+		return nil, &DbError{Message: "error while listing change events", OriginalError: err}
+	}
+	return events, nil
+}
+
+func (pm *postgresMadden) ListenChangeEvents(ctx context.Context, notify chan<- struct{}) error {
+	sqlDb, err := pm.db.DB()
+	if err != nil {
+		return &DbError{Message: "error while listening for change events", OriginalError: err}
+	}
+	//a listening connection is held for as long as the listen lasts
+	conn, err := sqlDb.Conn(ctx)
+	if err != nil {
+		return &DbError{Message: "error while listening for change events", OriginalError: err}
+	}
+	defer conn.Close()
+	err = conn.Raw(func(driverConn interface{}) error {
+		stdConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unsupported postgres driver %T", driverConn)
+		}
+		listener := stdConn.Conn()
+		if _, err := listener.Exec(ctx, "LISTEN "+CHANGE_EVENT_CHANNEL); err != nil {
+			return err
+		}
+		//a cancelled wait closes the connection, one that is still open goes back to the pool and must stop listening
+		defer func() {
+			if !listener.IsClosed() {
+				listener.Exec(context.Background(), "UNLISTEN "+CHANGE_EVENT_CHANNEL)
+			}
+		}()
+		for {
+			select {
+			case notify <- struct{}{}:
+			default:
+			}
+			if _, err := listener.WaitForNotification(ctx); err != nil {
+				return err
+			}
+		}
+	})
+	if ctx.Err() != nil {
+		return contextError(ctx.Err())
+	}
+	return &DbError{Message: "error while listening for change events", OriginalError: err}
+}
+
+func (pm *postgresMadden) PurgeChangeEvents(ctx context.Context, cutoff time.Time) (int64, error) {
+	purged := pm.db.WithContext(ctx).Where("created_at < ?", cutoff).Delete(&ChangeEvent{})
+	if purged.Error != nil {
+		return 0, &DbError{Message: "error while purging change events", OriginalError: purged.Error}
+	}
+	return purged.RowsAffected, nil
+}
+
+func (pm *postgresMadden) HistoricizeMaddenItems(ctx context.Context, cutoff time.Time, actor string) (JobRun, bool, error) {
+	run := JobRun{Name: JOB_HISTORICIZE, StartedAt: time.Now(), Cutoff: cutoff}
+	acquired := false
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		//the lock is released when the transaction ends, whichever instance holds it is the leader for this run
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", HISTORICIZE_LOCK).Scan(&acquired).Error; err != nil || !acquired {
+			return err
+		}
+		ids := []uint{}
+		//recurring items end with their last occurrence, those recurring forever never end
+		if err := tx.Model(&MaddenItem{}).Where("is_historical IS NOT TRUE AND ((rrule = '' AND end_date < ?) OR (rrule <> '' AND series_end <> 0 AND series_end < ?))", cutoff.Unix(), cutoff.Unix()).Order("id asc").Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := historicizeItem(tx, id, actor); err != nil {
+				return err
+			}
+		}
+		run.FinishedAt = time.Now()
+		run.Changed = int64(len(ids))
+		run.TotalChanged = run.Changed
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "name"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"started_at": run.StartedAt, "finished_at": run.FinishedAt, "cutoff": run.Cutoff, "changed": run.Changed, "total_changed": gorm.Expr("job_runs.total_changed + ?", run.Changed)}),
+		}).Create(&run).Error
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return JobRun{}, false, contextError(ctx.Err())
+		}
+		return JobRun{}, false, &DbError{Message: "error while historicizing items", OriginalError: err}
+	}
+	if !acquired {
+		return JobRun{}, false, nil
+	}
+	latest, err := pm.GetJobRun(ctx, JOB_HISTORICIZE)
+	return latest, true, err
+}
+
+func (pm *postgresMadden) GetJobRun(ctx context.Context, name string) (JobRun, error) {
+	run := JobRun{}
+	if err := pm.db.WithContext(ctx).Where("name = ?", name).Take(&run).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return JobRun{}, nil
+		}
+		return JobRun{}, &DbError{Message: fmt.Sprintf("error while reading the latest %s run", name), OriginalError: err}
+	}
+	return run, nil
+}
+
 //Implementation helpers
 
-//itemExists checks if a madden item with identical fields exists already, returns true if the item already existed, false if it did not
-func (pm *postgresMadden) itemExists(item MaddenItem) (bool, error) {
-	if err := pm.db.Where("end_date=? AND begin_date=? AND summary=? AND details=?", item.EndDate, item.BeginDate, item.Summary, item.Details).Take(&MaddenItem{}).Error; err != nil {
+//contextError wraps the error of a cancelled or expired context so callers can tell abandoned requests from failures
+func contextError(err error) error {
+	return &DbError{Message: "madden data store request abandoned", OriginalError: err}
+}
+
+//insertSummary inserts summary within tx, recording its creation and announcing it to webhooks if it differs from the current summary
+func insertSummary(tx *gorm.DB, summary Summary) (Summary, error) {
+	current := Summary{}
+	if err := tx.Order("created_at desc").Order("id desc").Take(&current).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return summary, err
+	}
+	if err := tx.Create(&summary).Error; err != nil {
+		return summary, err
+	}
+	if err := recordChangeEvent(tx, summaryCreatedPayload(summary)); err != nil {
+		return summary, err
+	}
+	if current.ID != 0 && current.Summary == summary.Summary {
+		return summary, nil
+	}
+	return summary, enqueueWebhookEvent(tx, summaryPayload(summary))
+}
+
+//insertPublished inserts published within tx, announcing it if it toggles the current state, madden starts unpublished
+func insertPublished(tx *gorm.DB, published Published) (Published, error) {
+	current := Published{}
+	if err := tx.Order("created_at desc").Order("id desc").Take(&current).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return published, err
+	}
+	if err := tx.Create(&published).Error; err != nil {
+		return published, err
+	}
+	if current.Published == published.Published {
+		return published, nil
+	}
+	if err := recordChangeEvent(tx, publishedPayload(published)); err != nil {
+		return published, err
+	}
+	return published, enqueueWebhookEvent(tx, publishedPayload(published))
+}
+
+//insertItem inserts the madden item, its images and its first revision within tx
+func insertItem(tx *gorm.DB, item MaddenItem, actor string) (MaddenItem, error) {
+	insertable := item
+	insertable.Version = 1
+	insertable.SeriesEnd = seriesEnd(item)
+	if err := validateRecurrence(item); err != nil {
+		return MaddenItem{}, err
+	}
+	//checked first for a clear error, the unique content index still rejects a concurrent duplicate
+	if err := checkDuplicateItem(tx, item); err != nil {
+		return MaddenItem{}, err
+	}
+	if err := lockLinkedImages(tx, item.ItemImages); err != nil {
+		return MaddenItem{}, err
+	}
+	if err := tx.Create(&insertable).Error; err != nil {
+		return MaddenItem{}, err
+	}
+	if err := tx.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&insertable).Error; err != nil {
+		return MaddenItem{}, &DbError{Message: "error while retrieving created item", OriginalError: err}
+	}
+	return insertable, recordRevision(tx, insertable, REVISION_CREATE, actor)
+}
+
+//updateItem updates the madden item stored at expectedVersion and records its revision within tx
+func updateItem(tx *gorm.DB, item MaddenItem, expectedVersion uint, actor string) (MaddenItem, error) {
+	insertable := item
+	//error is nil if the item existed
+	stored := MaddenItem{}
+	if err := tx.Preload("Occurrences").Take(&stored, item.ID).Error; err != nil {
+		return MaddenItem{}, &DbError{Message: "Error or item did not exist on update", OriginalError: err}
+	}
+	if stored.Version != expectedVersion {
+		return MaddenItem{}, staleVersionError("item", item.ID, stored.Version)
+	}
+	if err := validateRecurrence(item); err != nil {
+		return MaddenItem{}, err
+	}
+	if err := checkDuplicateItem(tx, item); err != nil {
+		return MaddenItem{}, err
+	}
+	if err := lockLinkedImages(tx, item.ItemImages); err != nil {
+		return MaddenItem{}, err
+	}
+	//exceptions of occurrences the updated series no longer has are dropped
+	withExceptions := insertable
+	withExceptions.Occurrences = keptOccurrences(item, stored.Occurrences)
+	mapped := entryToMap(insertable)
+	mapped["version"] = gorm.Expr("version + 1")
+	mapped["series_end"] = seriesEnd(withExceptions)
+	//the version condition catches a concurrent update committed since the item was read
+	updated := tx.Model(&insertable).Where("version = ?", expectedVersion).Updates(mapped)
+	if updated.Error != nil {
+		return MaddenItem{}, updated.Error
+	}
+	if updated.RowsAffected == 0 {
+		return MaddenItem{}, currentVersionError(tx, &MaddenItem{}, "item", item.ID)
+	}
+	if dropped := droppedOccurrenceIds(stored.Occurrences, withExceptions.Occurrences); len(dropped) > 0 {
+		if err := tx.Delete(&ItemOccurrence{}, dropped).Error; err != nil {
+			return MaddenItem{}, err
+		}
+	}
+	if err := tx.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&insertable).Error; err != nil {
+		return MaddenItem{}, &DbError{Message: "error while retrieving updated item", OriginalError: err}
+	}
+	return insertable, recordRevision(tx, insertable, REVISION_UPDATE, actor)
+}
+
+//historicizeItem marks the item with id historical within tx and records the revision, its images are left as they are
+func historicizeItem(tx *gorm.DB, id uint, actor string) error {
+	//columns are updated directly so the update hook does not replace the item images
+	if err := tx.Model(&MaddenItem{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{"is_historical": true, "version": gorm.Expr("version + 1"), "updated_at": time.Now()}).Error; err != nil {
+		return err
+	}
+	item := MaddenItem{}
+	if err := tx.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Take(&item, id).Error; err != nil {
+		return &DbError{Message: "error while retrieving historicized item", OriginalError: err}
+	}
+	return recordRevision(tx, item, REVISION_UPDATE, actor)
+}
+
+//checkDuplicateItem returns a ConflictError if a non deleted madden item other than item has identical fields
+func checkDuplicateItem(tx *gorm.DB, item MaddenItem) error {
+	existingId, err := findDuplicateItem(tx, item)
+	if err != nil {
+		return &DbError{Message: "error during check for existing item", OriginalError: err}
+	}
+	if existingId != 0 {
+		return duplicateItemError(existingId)
+	}
+	return nil
+}
+
+//findWindows returns the windows of live items between from and to, see itemWindows
+//if imageIds is not nil only items booking one of the image files are read
+func findWindows(db *gorm.DB, from, to int64, imageIds []uint) ([]MaddenItem, error) {
+	query := db.Where("((rrule = '' AND begin_date < ? AND end_date > ?) OR (rrule <> '' AND (series_end = 0 OR series_end > ?)))", to, from, from)
+	if imageIds != nil {
+		query = query.Where("id IN (SELECT madden_item_id FROM item_images WHERE madden_image_file_id IN ? AND deleted_at IS NULL)", imageIds)
+	}
+	items := []MaddenItem{}
+	if err := query.Order("id asc").Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&items).Error; err != nil {
+		return nil, &DbError{Message: "error on conflict search", OriginalError: err}
+	}
+	return itemWindows(items, from, to)
+}
+
+//windowFinder returns findWindows reading through tx, for writeConflicts
+func windowFinder(tx *gorm.DB) func(from, to int64, imageIds []uint) ([]MaddenItem, error) {
+	return func(from, to int64, imageIds []uint) ([]MaddenItem, error) {
+		return findWindows(tx, from, to, imageIds)
+	}
+}
+
+//checkItemConflicts returns the conflicts of item as written within tx with other live items, see writeConflicts
+//the images item books must have been locked by lockLinkedImages within tx
+func checkItemConflicts(tx *gorm.DB, item MaddenItem, strict bool) ([]ItemConflict, error) {
+	windows, _, _, err := candidateWindows(item)
+	if err != nil {
+		return nil, err
+	}
+	return writeConflicts(windows, strict, windowFinder(tx))
+}
+
+//findOccurrences expands the live recurring items matching historic into their occurrences within the dates that keep returns true for, see expandSeries
+//series that ended by endDate are skipped without expanding them
+func (pm *postgresMadden) findOccurrences(ctx context.Context, startDate, endDate int64, historic bool, keep func(occurrence MaddenItem) bool, limit int) ([]MaddenItem, error) {
+	series := []MaddenItem{}
+	if err := pm.db.WithContext(ctx).Where("rrule <> '' AND (series_end = 0 OR series_end > ?) AND is_historical = ?", endDate, historic).Order("id asc").Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&series).Error; err != nil {
+		return nil, &DbError{Message: "error on search", OriginalError: err}
+	}
+	return expandAllSeries(series, startDate, endDate, keep, limit)
+}
+
+//searchRow is a match of a search and its rank and snippets, the id is of an item or of an edited occurrence
+type searchRow struct {
+	ID             uint
+	Rank           float64
+	SummarySnippet string
+	DetailsSnippet string
+}
+
+//searchOccurrences returns the occurrences of the live recurring items matching historic within the dates that match terms, see expandSeries
+//occurrences are matched and ranked by postgres as other items are, through the search_vector of their series or the same vector built from an edited occurrence
+//only series with a match are expanded
+func (pm *postgresMadden) searchOccurrences(ctx context.Context, terms []SearchTerm, startDate, endDate int64, historic bool, limit int) ([]SearchResult, error) {
+	query := tsQuery(terms)
+	seriesFilter := "rrule <> '' AND (series_end = 0 OR series_end > ?) AND is_historical = ?"
+	seriesRows := []searchRow{}
+	if err := pm.db.WithContext(ctx).Model(&MaddenItem{}).Select("madden_items.id, "+searchSelection("search_vector", "summary", "details")).Joins("CROSS JOIN to_tsquery(?, ?) query", searchConfig, query).Where("search_vector @@ query").Where(seriesFilter, endDate, historic).Scan(&seriesRows).Error; err != nil {
+		return nil, &DbError{Message: "error on search", OriginalError: err}
+	}
+	editedRows := []searchRow{}
+	editedVector := searchVectorSql("item_occurrences.summary", "item_occurrences.details")
+	if err := pm.db.WithContext(ctx).Model(&ItemOccurrence{}).Select("item_occurrences.id, "+searchSelection(editedVector, "item_occurrences.summary", "item_occurrences.details")).Joins("CROSS JOIN to_tsquery(?, ?) query", searchConfig, query).Where("NOT cancelled AND "+editedVector+" @@ query").Where("madden_item_id IN (?)", pm.db.Model(&MaddenItem{}).Select("id").Where(seriesFilter, endDate, historic)).Scan(&editedRows).Error; err != nil {
+		return nil, &DbError{Message: "error on search", OriginalError: err}
+	}
+	if len(seriesRows) == 0 && len(editedRows) == 0 {
+		return []SearchResult{}, nil
+	}
+	seriesMatches, editedMatches := map[uint]searchRow{}, map[uint]searchRow{}
+	for _, row := range seriesRows {
+		seriesMatches[row.ID] = row
+	}
+	for _, row := range editedRows {
+		editedMatches[row.ID] = row
+	}
+	series := []MaddenItem{}
+	matched := pm.db.Model(&ItemOccurrence{}).Select("madden_item_id").Where("id IN ?", searchRowIds(editedMatches))
+	if err := pm.db.WithContext(ctx).Where("id IN ? OR id IN (?)", searchRowIds(seriesMatches), matched).Order("id asc").Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Find(&series).Error; err != nil {
+		return nil, &DbError{Message: "error on search", OriginalError: err}
+	}
+	results := []SearchResult{}
+	for _, recurring := range series {
+		//occurrences are matched by the row of their edit if they were edited, otherwise by the row of their series
+		rows := map[int64]searchRow{}
+		edited := map[int64]bool{}
+		for _, exception := range recurring.Occurrences {
+			edited[exception.OccurrenceStart] = true
+			if row, found := editedMatches[exception.ID]; found {
+				rows[exception.OccurrenceStart] = row
+			}
+		}
+		seriesRow, seriesMatched := seriesMatches[recurring.ID]
+		occurrences, err := expandSeries(recurring, startDate, endDate, func(occurrence MaddenItem) bool {
+			if edited[occurrence.OccurrenceStart] {
+				_, found := rows[occurrence.OccurrenceStart]
+				return found
+			}
+			return seriesMatched
+		}, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, occurrence := range occurrences {
+			row := seriesRow
+			if edited[occurrence.OccurrenceStart] {
+				row = rows[occurrence.OccurrenceStart]
+			}
+			results = append(results, SearchResult{Item: occurrence, Rank: row.Rank, SummarySnippet: row.SummarySnippet, DetailsSnippet: row.DetailsSnippet})
+		}
+	}
+	return results, nil
+}
+
+//searchRowIds returns the ids of rows
+func searchRowIds(rows map[uint]searchRow) []uint {
+	keys := []uint{}
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+//findImageByContent returns the image with the content hash, restoring it if it was deleted, found is false if no image has the hash
+func (pm *postgresMadden) findImageByContent(ctx context.Context, hash string) (MaddenImageFile, bool, error) {
+	existing := MaddenImageFile{}
+	err := pm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("content_hash = ?", hash).Take(&existing).Error; err != nil {
+			return err
+		}
+		if !existing.DeletedAt.Valid {
+			return nil
+		}
+		if err := tx.Unscoped().Model(&MaddenImageFile{}).Where("id = ?", existing.ID).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		existing.DeletedAt = gorm.DeletedAt{}
+		return nil
+	})
+	if err == gorm.ErrRecordNotFound {
+		return existing, false, nil
+	}
+	if err != nil {
+		return existing, false, &DbError{Message: "error while searching for image content", OriginalError: err}
+	}
+	return existing, true, nil
+}
+
+//lockImages locks the images selected by ids for update in order of id, deleted or not, ids is a slice of ids or a query selecting them
+//writes lock the images they book before checking conflicts so writes booking the same image are checked one after another
+func lockImages(tx *gorm.DB, ids interface{}) error {
+	locked := []uint{}
+	return tx.Unscoped().Model(&MaddenImageFile{}).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN (?)", ids).Order("id asc").Pluck("id", &locked).Error
+}
+
+//lockLinkedImages locks the images itemImages link as lockImages does, so they can not be deleted until the item is written
+//an error is returned if any of the images is missing or deleted
+func lockLinkedImages(tx *gorm.DB, itemImages []ItemImages) error {
+	ids := []uint{}
+	for _, itemImage := range itemImages {
+		ids = append(ids, itemImageFileId(itemImage))
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	found := []uint{}
+	if err := tx.Model(&MaddenImageFile{}).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", ids).Order("id asc").Pluck("id", &found).Error; err != nil {
+		return err
+	}
+	live := map[uint]bool{}
+	for _, id := range found {
+		live[id] = true
+	}
+	for _, id := range ids {
+		if !live[id] {
+			return fmt.Errorf("image %d does not exist", id)
+		}
+	}
+	return nil
+}
+
+//reviseItemImages records a change to the image links of the live item with itemId, incrementing its version and recording a revision attributed to actor
+//update column skips the BeforeUpdate hook which would replace the remaining links
+func reviseItemImages(tx *gorm.DB, itemId uint, actor string) error {
+	if err := tx.Model(&MaddenItem{}).Where("id = ?", itemId).UpdateColumns(map[string]interface{}{"version": gorm.Expr("version + 1"), "updated_at": time.Now()}).Error; err != nil {
+		return err
+	}
+	item := MaddenItem{}
+	if err := tx.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Take(&item, itemId).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			//links of deleted items are not revisions of a live item
+			return nil
+		}
+		return err
+	}
+	return recordRevision(tx, item, REVISION_UPDATE, actor)
+}
+
+//findDanglingItemImages returns live item images missing their item or image, or linking a live item to a deleted image
+func findDanglingItemImages(tx *gorm.DB) ([]ItemImages, error) {
+	dangling := []ItemImages{}
+	liveItems := tx.Session(&gorm.Session{NewDB: true}).Model(&MaddenItem{}).Select("id")
+	deletedImages := tx.Session(&gorm.Session{NewDB: true}).Unscoped().Model(&MaddenImageFile{}).Select("id").Where("deleted_at IS NOT NULL")
+	err := tx.Where("madden_item_id IS NULL OR madden_image_file_id IS NULL OR (madden_item_id IN (?) AND madden_image_file_id IN (?))", liveItems, deletedImages).Order("id asc").Find(&dangling).Error
+	return dangling, err
+}
+
+//imageInUseError returns an ImageInUseError for the image with id linked by the items with itemIds
+func imageInUseError(id uint, itemIds []uint) error {
+	return &ImageInUseError{Message: fmt.Sprintf("image %d is used by %d entries", id, len(itemIds)), ItemIds: itemIds}
+}
+
+//findDuplicateItem returns the id of a non deleted madden item other than item with identical fields, 0 if there is none
+func findDuplicateItem(tx *gorm.DB, item MaddenItem) (uint, error) {
+	existing := MaddenItem{}
+	if err := tx.Where("end_date=? AND begin_date=? AND summary=? AND details=? AND id<>?", item.EndDate, item.BeginDate, item.Summary, item.Details, item.ID).Take(&existing).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return false, nil
+			return 0, nil
+		}
+		return 0, err
+	}
+	return existing.ID, nil
+}
+
+//itemWriteError converts an error from writing item, a unique violation raised by a concurrent duplicate becomes a ConflictError
+func (pm *postgresMadden) itemWriteError(ctx context.Context, item MaddenItem, message string, err error) error {
+	switch err.(type) {
+	case *DbError, *ConflictError, *VersionConflictError, *OverlapError:
+		return err
+	}
+	var violation interface{ SQLState() string }
+	if errors.As(err, &violation) && violation.SQLState() == uniqueViolation {
+		//the transaction has rolled back so the committed duplicate is visible
+		if existingId, lookupErr := findDuplicateItem(pm.db.WithContext(ctx), item); lookupErr == nil && existingId != 0 {
+			return duplicateItemError(existingId)
 		}
-		return false, &DbError{Message: err.Error(), OriginalError: err}
 	}
-	return true, nil
+	return &DbError{Message: message, OriginalError: err}
+}
+
+//currentVersionError reads the stored version of the item or image with id after a conditional update matched no rows
+func currentVersionError(tx *gorm.DB, model interface{}, kind string, id uint) error {
+	current := struct{ Version uint }{}
+	if err := tx.Model(model).Select("version").Where("id = ?", id).Take(&current).Error; err != nil {
+		return &DbError{Message: fmt.Sprintf("error reading version of %s %d", kind, id), OriginalError: err}
+	}
+	return staleVersionError(kind, id, current.Version)
+}
+
+//staleVersionError returns the error for an update that expected a version other than current
+func staleVersionError(kind string, id, current uint) error {
+	return &VersionConflictError{Message: fmt.Sprintf("%s %d has been modified, current version is %d", kind, id, current), CurrentVersion: current}
+}
+
+func duplicateItemError(existingId uint) error {
+	return &ConflictError{Message: fmt.Sprintf("Item Already existed with id %d", existingId), ExistingId: existingId}
+}
+
+func duplicateImageError(existing MaddenImageFile) error {
+	return &ConflictError{Message: fmt.Sprintf("image with filename %s already exists", existing.FileName), ExistingId: existing.ID}
+}
+
+//recordRevision records the current state of item as its next revision within tx
+func recordRevision(tx *gorm.DB, item MaddenItem, action, actor string) error {
+	var previous *ItemRevision
+	latest := ItemRevision{}
+	if err := tx.Where("madden_item_id = ?", item.ID).Order("revision desc").Take(&latest).Error; err == nil {
+		previous = &latest
+	} else if err != gorm.ErrRecordNotFound {
+		return &DbError{Message: "error while reading item history", OriginalError: err}
+	}
+	revision, err := buildRevision(item.ID, action, actor, previous, SnapshotItem(item))
+	if err != nil {
+		return err
+	}
+	if err := tx.Create(&revision).Error; err != nil {
+		return &DbError{Message: "error while recording item history", OriginalError: err}
+	}
+	payload, err := entryPayload(item, revision)
+	if err != nil {
+		return err
+	}
+	if err := recordChangeEvent(tx, payload); err != nil {
+		return err
+	}
+	return enqueueWebhookEvent(tx, payload)
+}
+
+//enqueueWebhookEvent writes a delivery of payload to the outbox within tx for every subscription to its event
+func enqueueWebhookEvent(tx *gorm.DB, payload WebhookPayload) error {
+	subscriptions := []WebhookSubscription{}
+	if err := tx.Order("id asc").Find(&subscriptions).Error; err != nil {
+		return &DbError{Message: "error while reading webhooks", OriginalError: err}
+	}
+	deliveries, err := buildDeliveries(subscriptions, payload)
+	if err != nil || len(deliveries) == 0 {
+		return err
+	}
+	if err := tx.Omit(clause.Associations).Create(&deliveries).Error; err != nil {
+		return &DbError{Message: "error while queueing webhook deliveries", OriginalError: err}
+	}
+	return nil
 }
 
 func itemOrderString(sortField SortField, startDate, endDate int64, reverse bool) string {
@@ -252,6 +1553,14 @@ func itemOrderString(sortField SortField, startDate, endDate int64, reverse bool
 	}
 }
 
+//itemKeysetString selects rows after an ItemCursor.keyset, the columns must match the order built by itemOrderString
+func itemKeysetString(sortField SortField) string {
+	if sortField == StartDate {
+		return "(begin_date, end_date, id) > (?, ?, ?)"
+	}
+	return "(end_date, begin_date, id) > (?, ?, ?)"
+}
+
 //no required image order so order by creation time
 func imageOrderString() string {
 	return "created_at asc"
@@ -266,5 +1575,98 @@ func entryToMap(entry MaddenItem) map[string]interface{} {
 		"summary":       entry.Summary,
 		"details":       entry.Details,
 		"is_historical": entry.IsHistorical,
+		"rrule":         entry.RRule,
+	}
+}
+
+//imageToMap maps the non empty fields of image for update, matching gorm struct updates, and increments the version
+func imageToMap(image MaddenImageFile) map[string]interface{} {
+	mapped := map[string]interface{}{"version": gorm.Expr("version + 1")}
+	if image.FileName != "" {
+		mapped["file_name"] = image.FileName
+	}
+	if image.Thumbnail != "" {
+		mapped["thumbnail"] = image.Thumbnail
+	}
+	return mapped
+}
+
+//pgImportStore applies an import within a postgres transaction
+type pgImportStore struct {
+	tx *gorm.DB
+}
+
+func (store *pgImportStore) findImageByName(fileName string) (*MaddenImageFile, error) {
+	image := MaddenImageFile{}
+	if err := store.tx.Unscoped().Where("file_name = ?", fileName).Take(&image).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, &DbError{Message: "error during check for existing image", OriginalError: err}
+	}
+	return &image, nil
+}
+
+func (store *pgImportStore) createImage(image MaddenImageFile) (MaddenImageFile, error) {
+	if err := store.tx.Create(&image).Error; err != nil {
+		return image, &DbError{Message: "error while inserting image into database", OriginalError: err}
+	}
+	return image, nil
+}
+
+func (store *pgImportStore) updateImageThumbnail(id uint, thumbnail string) error {
+	if err := store.tx.Model(&MaddenImageFile{}).Where("id = ?", id).Updates(imageToMap(MaddenImageFile{Thumbnail: thumbnail})).Error; err != nil {
+		return &DbError{Message: fmt.Sprintf("error while updating image with id %d", id), OriginalError: err}
+	}
+	return nil
+}
+
+func (store *pgImportStore) findDuplicateItem(item MaddenItem) (uint, error) {
+	return findDuplicateItem(store.tx, item)
+}
+
+func (store *pgImportStore) getItem(id uint) (MaddenItem, error) {
+	item := MaddenItem{}
+	if err := store.tx.Preload("ItemImages").Preload("ItemImages.MaddenImageFile").Preload("Occurrences").Take(&item, id).Error; err != nil {
+		return item, &DbError{Message: fmt.Sprintf("error retrieving item with ID: %d", id), OriginalError: err}
+	}
+	return item, nil
+}
+
+func (store *pgImportStore) createItem(item MaddenItem, actor string) (MaddenItem, error) {
+	return insertItem(store.tx, item, actor)
+}
+
+func (store *pgImportStore) updateItem(item MaddenItem, expectedVersion uint, actor string) (MaddenItem, error) {
+	return updateItem(store.tx, item, expectedVersion, actor)
+}
+
+func (store *pgImportStore) currentSummary() (Summary, error) {
+	summary := Summary{}
+	if err := store.tx.Order("created_at desc").Order("id desc").Take(&summary).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return summary, &DbError{Message: "error retrieving summary", OriginalError: err}
 	}
+	return summary, nil
+}
+
+func (store *pgImportStore) createSummary(summary Summary) error {
+	if _, err := insertSummary(store.tx, summary); err != nil {
+		return &DbError{Message: "error creating summary", OriginalError: err}
+	}
+	return nil
+}
+
+func (store *pgImportStore) currentPublished() (Published, error) {
+	published := Published{}
+	if err := store.tx.Order("created_at desc").Order("id desc").Take(&published).Error; err != nil && err != gorm.ErrRecordNotFound {
+		return published, &DbError{Message: "error retrieving published state", OriginalError: err}
+	}
+	return published, nil
+}
+
+func (store *pgImportStore) createPublished(published Published) error {
+	if _, err := insertPublished(store.tx, published); err != nil {
+		return &DbError{Message: "error creating published state", OriginalError: err}
+	}
+	return nil
 }
@@ -0,0 +1,1666 @@
+package maddendb
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//in memory implementation of Madden, intended for tests and local development
+//behavior mirrors postgresMadden including soft deletes and unique constraints
+type memoryMadden struct {
+	lock      sync.RWMutex
+	summaries []Summary
+	published []Published
+	items     map[uint]*MaddenItem
+	images    map[uint]*MaddenImageFile
+	//item images are stored separately from items the same way the join table is
+	itemImages map[uint]*ItemImages
+	//revisions are append only, mirroring the immutable item_revisions table
+	revisions []ItemRevision
+	//webhook subscriptions and their outbox, ids are the position plus one
+	webhooks   []WebhookSubscription
+	deliveries []WebhookDelivery
+	//the change event log ordered by id, and the channels notified as events are appended
+	changeEvents []ChangeEvent
+	listeners    map[chan<- struct{}]bool
+	//the latest run of each background job by name
+	jobRuns map[string]JobRun
+	//next ids to hand out, mirrors a postgres sequence
+	nextItemId        uint
+	nextImageId       uint
+	nextItemImageId   uint
+	nextChangeEventId uint
+	nextOccurrenceId  uint
+}
+
+//in memory constructor
+func NewMemoryMadden() Madden {
+	return &memoryMadden{
+		items:             map[uint]*MaddenItem{},
+		images:            map[uint]*MaddenImageFile{},
+		itemImages:        map[uint]*ItemImages{},
+		listeners:         map[chan<- struct{}]bool{},
+		jobRuns:           map[string]JobRun{},
+		nextItemId:        1,
+		nextImageId:       1,
+		nextItemImageId:   1,
+		nextChangeEventId: 1,
+		nextOccurrenceId:  1,
+	}
+}
+
+//Interface implementation
+
+func (mm *memoryMadden) GetSummary(ctx context.Context) (Summary, error) {
+	if err := ctx.Err(); err != nil {
+		return Summary{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	for i := len(mm.summaries) - 1; i >= 0; i-- {
+		if !mm.summaries[i].DeletedAt.Valid {
+			return mm.summaries[i], nil
+		}
+	}
+	return Summary{}, nil
+}
+
+func (mm *memoryMadden) CreateSummary(ctx context.Context, summary Summary) (Summary, error) {
+	if err := ctx.Err(); err != nil {
+		return Summary{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	return mm.createSummary(summary)
+}
+
+func (mm *memoryMadden) GetPublished(ctx context.Context) (Published, error) {
+	if err := ctx.Err(); err != nil {
+		return Published{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	for i := len(mm.published) - 1; i >= 0; i-- {
+		if !mm.published[i].DeletedAt.Valid {
+			return mm.published[i], nil
+		}
+	}
+	return Published{}, nil
+}
+
+func (mm *memoryMadden) CreatePublished(ctx context.Context, published Published) (Published, error) {
+	if err := ctx.Err(); err != nil {
+		return Published{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	return mm.createPublished(published)
+}
+
+func (mm *memoryMadden) DeleteMaddenImage(ctx context.Context, id uint, cascade bool, actor string) error {
+	if err := ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	image, exists := mm.images[id]
+	if !exists || image.DeletedAt.Valid {
+		return nil
+	}
+	itemIds := mm.imageUsageIds(id)
+	if len(itemIds) > 0 && !cascade {
+		return imageInUseError(id, itemIds)
+	}
+	for _, itemId := range itemIds {
+		for linkId, itemImage := range mm.itemImages {
+			if itemImage.MaddenItemId == itemId && itemImage.MaddenImageFileId == id {
+				delete(mm.itemImages, linkId)
+			}
+		}
+		if err := mm.reviseItemImages(itemId, actor); err != nil {
+			return &DbError{Message: fmt.Sprintf("error deleting image %d", id), OriginalError: err}
+		}
+	}
+	image.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	return nil
+}
+
+func (mm *memoryMadden) GetMaddenImageById(ctx context.Context, id uint) (MaddenImageFile, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenImageFile{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	image, exists := mm.images[id]
+	if !exists || image.DeletedAt.Valid {
+		return MaddenImageFile{}, &DbError{Message: fmt.Sprintf("image with ID: %d did not exist", id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	return *image, nil
+}
+
+func (mm *memoryMadden) GetAllMaddenImages(ctx context.Context) ([]MaddenImageFile, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	images := []MaddenImageFile{}
+	for _, image := range mm.images {
+		images = append(images, *image)
+	}
+	sort.Slice(images, func(i, j int) bool { return images[i].ID < images[j].ID })
+	return images, nil
+}
+
+func (mm *memoryMadden) GetDanglingItemImages(ctx context.Context) ([]ItemImages, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	return mm.findDanglingItemImages(), nil
+}
+
+func (mm *memoryMadden) RemoveDanglingItemImages(ctx context.Context, actor string) ([]ItemImages, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	dangling := mm.findDanglingItemImages()
+	for _, itemImage := range dangling {
+		delete(mm.itemImages, itemImage.ID)
+	}
+	revised := map[uint]bool{}
+	for _, itemImage := range dangling {
+		if itemImage.MaddenItemId == 0 || revised[itemImage.MaddenItemId] {
+			continue
+		}
+		revised[itemImage.MaddenItemId] = true
+		if err := mm.reviseItemImages(itemImage.MaddenItemId, actor); err != nil {
+			return nil, &DbError{Message: "error while removing dangling item images", OriginalError: err}
+		}
+	}
+	return dangling, nil
+}
+
+func (mm *memoryMadden) SetupDatabase(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	return nil
+}
+
+func (mm *memoryMadden) CreateMaddenItem(ctx context.Context, item MaddenItem, strict bool, actor string) (MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenItem{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	saved := mm.copyData()
+	created, err := mm.createItem(item, actor)
+	if err != nil {
+		return MaddenItem{}, err
+	}
+	if created.Conflicts, err = mm.checkItemConflicts(created, strict); err != nil {
+		mm.restoreData(saved)
+		return MaddenItem{}, unwrittenOverlap(err, item.ID)
+	}
+	return created, nil
+}
+
+func (mm *memoryMadden) UpdateMaddenItem(ctx context.Context, item MaddenItem, expectedVersion uint, strict bool, actor string) (MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenItem{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	saved := mm.copyData()
+	updated, err := mm.updateItem(item, expectedVersion, actor)
+	if err != nil {
+		return MaddenItem{}, err
+	}
+	if updated.Conflicts, err = mm.checkItemConflicts(updated, strict); err != nil {
+		mm.restoreData(saved)
+		return MaddenItem{}, err
+	}
+	return updated, nil
+}
+
+func (mm *memoryMadden) DeleteMaddenItem(ctx context.Context, id uint, actor string) error {
+	if err := ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if item, exists := mm.items[id]; exists && !item.DeletedAt.Valid {
+		item.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		if err := mm.recordRevision(mm.loadItem(item), REVISION_DELETE, actor); err != nil {
+			return &DbError{Message: fmt.Sprintf("error deleting entry %d", id), OriginalError: err}
+		}
+	}
+	return nil
+}
+
+func (mm *memoryMadden) GetMaddenItemRevisions(ctx context.Context, id uint) ([]ItemRevision, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	revisions := []ItemRevision{}
+	for _, revision := range mm.revisions {
+		if revision.MaddenItemId == id {
+			revisions = append(revisions, revision)
+		}
+	}
+	if len(revisions) == 0 {
+		return nil, &DbError{Message: fmt.Sprintf("item with ID: %d has no history", id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	return revisions, nil
+}
+
+func (mm *memoryMadden) GetMaddenItemRevision(ctx context.Context, id, revision uint) (ItemRevision, error) {
+	if err := ctx.Err(); err != nil {
+		return ItemRevision{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	for _, found := range mm.revisions {
+		if found.MaddenItemId == id && found.Revision == revision {
+			return found, nil
+		}
+	}
+	return ItemRevision{}, &DbError{Message: fmt.Sprintf("revision %d of item %d did not exist", revision, id), OriginalError: gorm.ErrRecordNotFound}
+}
+
+func (mm *memoryMadden) GetMaddenItems(ctx context.Context, pageNum, size int, startDate, endDate int64, sortField SortField, historic bool) ([]MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	matched := []*MaddenItem{}
+	for _, item := range mm.items {
+		if item.DeletedAt.Valid || item.RRule != "" {
+			continue
+		}
+		if item.BeginDate < startDate && item.EndDate > endDate && item.IsHistorical == historic {
+			matched = append(matched, item)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return itemLess(matched[i], matched[j], sortField)
+	})
+	//every page up to this one may be filled by occurrences
+	occurrences, err := mm.findOccurrences(startDate, endDate, historic, func(MaddenItem) bool { return true }, (pageNum+1)*size)
+	if err != nil {
+		return nil, err
+	}
+	items := []MaddenItem{}
+	_, end := pageBounds(len(matched), pageNum, size)
+	for _, item := range matched[:end] {
+		items = append(items, mm.loadItem(item))
+	}
+	merged := mergeItems(items, occurrences, sortField)
+	start, end := pageBounds(len(merged), pageNum, size)
+	return merged[start:end], nil
+}
+
+func (mm *memoryMadden) GetMaddenItemsAfter(ctx context.Context, cursor *ItemCursor, size int, startDate, endDate int64, sortField SortField, historic bool) ([]MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	matched := []*MaddenItem{}
+	for _, item := range mm.items {
+		if item.DeletedAt.Valid || item.RRule != "" || !(item.BeginDate < startDate && item.EndDate > endDate && item.IsHistorical == historic) {
+			continue
+		}
+		if cursor != nil && !cursor.precedes(*item) {
+			continue
+		}
+		matched = append(matched, item)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return itemLess(matched[i], matched[j], sortField)
+	})
+	occurrences, err := mm.findOccurrences(startDate, endDate, historic, func(occurrence MaddenItem) bool { return cursor == nil || cursor.precedes(occurrence) }, size)
+	if err != nil {
+		return nil, err
+	}
+	items := []MaddenItem{}
+	_, end := pageBounds(len(matched), 0, size)
+	for _, item := range matched[:end] {
+		items = append(items, mm.loadItem(item))
+	}
+	merged := mergeItems(items, occurrences, sortField)
+	_, end = pageBounds(len(merged), 0, size)
+	return merged[:end], nil
+}
+
+func (mm *memoryMadden) FindMaddenItemConflicts(ctx context.Context, item MaddenItem) ([]ItemConflict, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	imageIds := itemImageIds(item)
+	if len(imageIds) == 0 {
+		return []ItemConflict{}, nil
+	}
+	if stored, exists := mm.items[item.ID]; exists && item.RRule != "" {
+		item.Occurrences = keptOccurrences(item, mm.loadItem(stored).Occurrences)
+	}
+	candidates, from, to, err := candidateWindows(item)
+	if err != nil {
+		return nil, err
+	}
+	others, err := mm.findWindows(from, to, imageIds)
+	if err != nil {
+		return nil, err
+	}
+	return candidateConflicts(candidates, others), nil
+}
+
+func (mm *memoryMadden) GetMaddenItemConflicts(ctx context.Context, from, to int64) ([]ItemConflict, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	windows, err := mm.findWindows(from, to, nil)
+	if err != nil {
+		return nil, err
+	}
+	return windowConflicts(windows), nil
+}
+
+func (mm *memoryMadden) GetMaddenStatus(ctx context.Context, at int64) (MaddenStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenStatus{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	images := []MaddenImageFile{}
+	for _, image := range mm.images {
+		if !image.DeletedAt.Valid {
+			images = append(images, *image)
+		}
+	}
+	//windows starting at or before at and ending after it
+	windows, err := mm.findWindows(at, at+1, nil)
+	if err != nil {
+		return MaddenStatus{}, err
+	}
+	return buildStatus(at, images, windows), nil
+}
+
+func (mm *memoryMadden) GetMaddenAvailability(ctx context.Context, boundaries []int64) ([]PeriodAvailability, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	if err := availabilityBoundariesValid(boundaries); err != nil {
+		return nil, err
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	images := []MaddenImageFile{}
+	for _, image := range mm.images {
+		if !image.DeletedAt.Valid {
+			images = append(images, *image)
+		}
+	}
+	windows, err := mm.findWindows(boundaries[0], boundaries[len(boundaries)-1], nil)
+	if err != nil {
+		return nil, err
+	}
+	return buildAvailability(boundaries, images, windows), nil
+}
+
+func (mm *memoryMadden) SearchMaddenItems(ctx context.Context, query string, pageNum, size int, startDate, endDate int64, historic bool) ([]SearchResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	terms, err := ParseSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	matched := []SearchResult{}
+	for _, item := range mm.items {
+		if item.DeletedAt.Valid || item.RRule != "" || !(item.BeginDate < startDate && item.EndDate > endDate && item.IsHistorical == historic) {
+			continue
+		}
+		if result, found := searchItem(*item, terms); found {
+			matched = append(matched, result)
+		}
+	}
+	occurrences, err := mm.findOccurrences(startDate, endDate, historic, func(occurrence MaddenItem) bool {
+		_, found := searchItem(occurrence, terms)
+		return found
+	}, (pageNum+1)*size)
+	if err != nil {
+		return nil, err
+	}
+	for _, occurrence := range occurrences {
+		result, _ := searchItem(occurrence, terms)
+		matched = append(matched, result)
+	}
+	sortSearchResults(matched)
+	results := []SearchResult{}
+	start, end := pageBounds(len(matched), pageNum, size)
+	for _, result := range matched[start:end] {
+		//occurrences are loaded as they are expanded
+		if result.Item.OccurrenceStart == 0 {
+			result.Item = mm.loadItem(mm.items[result.Item.ID])
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (mm *memoryMadden) GetMaddenItemById(ctx context.Context, id uint) (MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenItem{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	item, exists := mm.items[id]
+	if !exists || item.DeletedAt.Valid {
+		return MaddenItem{}, &DbError{Message: fmt.Sprintf("item with ID: %d did not exist", id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	return mm.loadItem(item), nil
+}
+
+func (mm *memoryMadden) GetMaddenItemsLastModified(ctx context.Context) (time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	latest := time.Time{}
+	for _, item := range mm.items {
+		if item.UpdatedAt.After(latest) {
+			latest = item.UpdatedAt
+		}
+		if item.DeletedAt.Valid && item.DeletedAt.Time.After(latest) {
+			latest = item.DeletedAt.Time
+		}
+	}
+	return latest, nil
+}
+
+func (mm *memoryMadden) SetMaddenItemOccurrence(ctx context.Context, id uint, occurrence ItemOccurrence, expectedVersion uint, strict bool, actor string) (MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenItem{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	stored, exists := mm.items[id]
+	if !exists || stored.DeletedAt.Valid {
+		return MaddenItem{}, &DbError{Message: fmt.Sprintf("item with ID: %d did not exist", id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	if stored.Version != expectedVersion {
+		return MaddenItem{}, staleVersionError("item", id, stored.Version)
+	}
+	if err := checkOccurrence(*stored, occurrence.OccurrenceStart); err != nil {
+		return MaddenItem{}, err
+	}
+	saved := mm.copyData()
+	now := time.Now()
+	occurrence.ID, occurrence.MaddenItemId, occurrence.CreatedAt, occurrence.UpdatedAt = mm.nextOccurrenceId, id, now, now
+	for _, exception := range stored.Occurrences {
+		if exception.OccurrenceStart == occurrence.OccurrenceStart {
+			occurrence.ID, occurrence.CreatedAt = exception.ID, exception.CreatedAt
+		}
+	}
+	if occurrence.ID == mm.nextOccurrenceId {
+		mm.nextOccurrenceId++
+	}
+	stored.Occurrences = setOccurrence(stored.Occurrences, occurrence)
+	stored.SeriesEnd = seriesEnd(*stored)
+	stored.Version++
+	stored.UpdatedAt = now
+	updated := mm.loadItem(stored)
+	if err := mm.recordRevision(updated, REVISION_UPDATE, actor); err != nil {
+		mm.restoreData(saved)
+		return MaddenItem{}, err
+	}
+	var err error
+	if updated.Conflicts, err = writeConflicts(occurrenceWindows(updated, occurrence), strict, mm.findWindows); err != nil {
+		mm.restoreData(saved)
+		return MaddenItem{}, err
+	}
+	return updated, nil
+}
+
+func (mm *memoryMadden) CreateMaddenImage(ctx context.Context, image MaddenImageFile) (MaddenImageFile, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenImageFile{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	return mm.createImage(image)
+}
+
+func (mm *memoryMadden) FindOrCreateMaddenImage(ctx context.Context, image MaddenImageFile) (MaddenImageFile, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenImageFile{}, false, contextError(err)
+	}
+	if image.ContentHash == "" {
+		return image, false, &DbError{Message: "image content hash is required"}
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	for _, existing := range mm.images {
+		if existing.ContentHash == image.ContentHash {
+			existing.DeletedAt = gorm.DeletedAt{}
+			return *existing, false, nil
+		}
+	}
+	created, err := mm.createImage(image)
+	return created, err == nil, err
+}
+
+func (mm *memoryMadden) UpdateMaddenImage(ctx context.Context, image MaddenImageFile, expectedVersion uint) (MaddenImageFile, MaddenImageFile, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenImageFile{}, MaddenImageFile{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	stored, exists := mm.images[image.ID]
+	if !exists || stored.DeletedAt.Valid {
+		return MaddenImageFile{}, image, &DbError{Message: fmt.Sprintf("image with id %d did not exist", image.ID), OriginalError: gorm.ErrRecordNotFound}
+	}
+	original := *stored
+	if stored.Version != expectedVersion {
+		return original, image, staleVersionError("image", image.ID, stored.Version)
+	}
+	if err := mm.imageConstraintsValid(image.ID, image, false); err != nil {
+		return original, image, &DbError{Message: fmt.Sprintf("error while updating item with id %d", image.ID), OriginalError: err}
+	}
+	//gorm struct updates only write non zero fields
+	if image.FileName != "" {
+		stored.FileName = image.FileName
+	}
+	if image.Thumbnail != "" {
+		stored.Thumbnail = image.Thumbnail
+	}
+	stored.Version++
+	stored.UpdatedAt = time.Now()
+	return original, *stored, nil
+}
+
+func (mm *memoryMadden) GetMaddenImageUsages(ctx context.Context, id uint) ([]MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	items := []MaddenItem{}
+	for _, itemId := range mm.imageUsageIds(id) {
+		items = append(items, mm.loadItem(mm.items[itemId]))
+	}
+	return items, nil
+}
+
+func (mm *memoryMadden) GetMaddenImages(ctx context.Context, pageNum, size int) ([]MaddenImageFile, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	return mm.findImages(pageNum, size, func(image *MaddenImageFile) bool { return true }), nil
+}
+
+func (mm *memoryMadden) GetMaddenImagesAfter(ctx context.Context, cursor *ImageCursor, size int) ([]MaddenImageFile, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	return mm.findImages(0, size, func(image *MaddenImageFile) bool {
+		return cursor == nil || imageLess(&MaddenImageFile{Model: gorm.Model{ID: cursor.ID, CreatedAt: cursor.CreatedAt}}, image)
+	}), nil
+}
+
+func (mm *memoryMadden) GetMaddenImagesByName(ctx context.Context, pageNum, size int, filename string) ([]MaddenImageFile, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	matcher, err := regexp.Compile(filename)
+	if err != nil {
+		return []MaddenImageFile{}, &DbError{Message: "error while searching for images", OriginalError: err}
+	}
+	return mm.findImages(pageNum, size, func(image *MaddenImageFile) bool { return matcher.MatchString(image.FileName) }), nil
+}
+
+func (mm *memoryMadden) GetDeletedMaddenItems(ctx context.Context, pageNum, size int) ([]MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	matched := []*MaddenItem{}
+	for _, item := range mm.items {
+		if item.DeletedAt.Valid {
+			matched = append(matched, item)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return deletedLess(matched[i].Model, matched[j].Model)
+	})
+	items := []MaddenItem{}
+	start, end := pageBounds(len(matched), pageNum, size)
+	for _, item := range matched[start:end] {
+		items = append(items, mm.loadItem(item))
+	}
+	return items, nil
+}
+
+func (mm *memoryMadden) GetDeletedMaddenImages(ctx context.Context, pageNum, size int) ([]MaddenImageFile, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	matched := []*MaddenImageFile{}
+	for _, image := range mm.images {
+		if image.DeletedAt.Valid {
+			matched = append(matched, image)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return deletedLess(matched[i].Model, matched[j].Model)
+	})
+	images := []MaddenImageFile{}
+	start, end := pageBounds(len(matched), pageNum, size)
+	for _, image := range matched[start:end] {
+		images = append(images, *image)
+	}
+	return images, nil
+}
+
+func (mm *memoryMadden) RestoreMaddenItem(ctx context.Context, id uint, strict bool, actor string) (MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return MaddenItem{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	item, exists := mm.items[id]
+	if !exists || !item.DeletedAt.Valid {
+		return MaddenItem{}, &DbError{Message: fmt.Sprintf("item with ID: %d was not in the trash", id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	if existingId := mm.findDuplicateItem(*item); existingId != 0 {
+		return MaddenItem{}, duplicateItemError(existingId)
+	}
+	saved := mm.copyData()
+	item.DeletedAt = gorm.DeletedAt{}
+	item.UpdatedAt = time.Now()
+	for _, itemImage := range mm.itemImages {
+		if itemImage.MaddenItemId != id || itemImage.DeletedAt.Valid {
+			continue
+		}
+		if image, exists := mm.images[itemImage.MaddenImageFileId]; exists {
+			image.DeletedAt = gorm.DeletedAt{}
+		}
+	}
+	restored := mm.loadItem(item)
+	if err := mm.recordRevision(restored, REVISION_RESTORE, actor); err != nil {
+		mm.restoreData(saved)
+		return MaddenItem{}, err
+	}
+	var err error
+	if restored.Conflicts, err = mm.checkItemConflicts(restored, strict); err != nil {
+		mm.restoreData(saved)
+		return MaddenItem{}, err
+	}
+	return restored, nil
+}
+
+func (mm *memoryMadden) PurgeDeleted(ctx context.Context, cutoff time.Time) (PurgeResult, error) {
+	if err := ctx.Err(); err != nil {
+		return PurgeResult{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	purged := PurgeResult{}
+	for id, item := range mm.items {
+		if item.DeletedAt.Valid && item.DeletedAt.Time.Before(cutoff) {
+			delete(mm.items, id)
+			purged.Items++
+		}
+	}
+	linked := map[uint]bool{}
+	for id, itemImage := range mm.itemImages {
+		if _, exists := mm.items[itemImage.MaddenItemId]; !exists {
+			delete(mm.itemImages, id)
+			continue
+		}
+		linked[itemImage.MaddenImageFileId] = true
+	}
+	//images still linked to an item, deleted or not, are kept so the item can be restored intact
+	for id, image := range mm.images {
+		if image.DeletedAt.Valid && image.DeletedAt.Time.Before(cutoff) && !linked[id] {
+			delete(mm.images, id)
+			purged.Images++
+		}
+	}
+	return purged, nil
+}
+
+func (mm *memoryMadden) GetMaddenItemsAfterId(ctx context.Context, afterId uint, size int) ([]MaddenItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	matched := []*MaddenItem{}
+	for _, item := range mm.items {
+		if !item.DeletedAt.Valid && item.ID > afterId {
+			matched = append(matched, item)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+	items := []MaddenItem{}
+	for _, item := range matched {
+		if len(items) == size {
+			break
+		}
+		items = append(items, mm.loadItem(item))
+	}
+	return items, nil
+}
+
+func (mm *memoryMadden) ImportMadden(ctx context.Context, records []ImportRecord, mode ImportMode, dryRun bool, actor string) (ImportResult, error) {
+	if err := ctx.Err(); err != nil {
+		return ImportResult{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	//mirrors the import transaction, the data is put back if the import is not committed
+	saved := mm.copyData()
+	imp := newImporter(mode, actor)
+	store := &memoryImportStore{mm: mm}
+	for _, record := range records {
+		outcome, err := imp.apply(store, record)
+		imp.count(record.Line, outcome, err)
+	}
+	if dryRun || len(imp.result.Errors) > 0 {
+		mm.restoreData(saved)
+	}
+	return imp.result, nil
+}
+
+func (mm *memoryMadden) CreateWebhook(ctx context.Context, subscription WebhookSubscription) (WebhookSubscription, error) {
+	if err := ctx.Err(); err != nil {
+		return WebhookSubscription{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	created := subscription
+	created.Model = newModel(uint(len(mm.webhooks) + 1))
+	mm.webhooks = append(mm.webhooks, created)
+	return created, nil
+}
+
+func (mm *memoryMadden) GetWebhooks(ctx context.Context) ([]WebhookSubscription, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	return mm.liveWebhooks(), nil
+}
+
+func (mm *memoryMadden) GetWebhookById(ctx context.Context, id uint) (WebhookSubscription, error) {
+	if err := ctx.Err(); err != nil {
+		return WebhookSubscription{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	if id == 0 || id > uint(len(mm.webhooks)) || mm.webhooks[id-1].DeletedAt.Valid {
+		return WebhookSubscription{}, &DbError{Message: fmt.Sprintf("webhook with ID: %d did not exist", id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	return mm.webhooks[id-1], nil
+}
+
+func (mm *memoryMadden) DeleteWebhook(ctx context.Context, id uint) error {
+	if err := ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if id == 0 || id > uint(len(mm.webhooks)) || mm.webhooks[id-1].DeletedAt.Valid {
+		return nil
+	}
+	mm.webhooks[id-1].DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	return nil
+}
+
+func (mm *memoryMadden) GetWebhookDeliveries(ctx context.Context, id uint, status string, pageNum, size int) ([]WebhookDelivery, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	matching := []WebhookDelivery{}
+	for i := len(mm.deliveries) - 1; i >= 0; i-- {
+		delivery := mm.deliveries[i]
+		if delivery.WebhookSubscriptionId == id && (status == "" || delivery.Status == status) {
+			matching = append(matching, delivery)
+		}
+	}
+	start, end := pageBounds(len(matching), pageNum, size)
+	return matching[start:end], nil
+}
+
+func (mm *memoryMadden) RedeliverWebhookDelivery(ctx context.Context, id, deliveryId uint) (WebhookDelivery, error) {
+	if err := ctx.Err(); err != nil {
+		return WebhookDelivery{}, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if deliveryId == 0 || deliveryId > uint(len(mm.deliveries)) || mm.deliveries[deliveryId-1].WebhookSubscriptionId != id {
+		return WebhookDelivery{}, &DbError{Message: fmt.Sprintf("delivery %d of webhook %d could not be redelivered", deliveryId, id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	delivery := &mm.deliveries[deliveryId-1]
+	delivery.Status = DELIVERY_PENDING
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	delivery.DeliveredAt = nil
+	delivery.UpdatedAt = time.Now()
+	return *delivery, nil
+}
+
+func (mm *memoryMadden) ClaimWebhookDeliveries(ctx context.Context, now time.Time, size int, lease time.Duration) ([]WebhookDelivery, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	due := []*WebhookDelivery{}
+	for i := range mm.deliveries {
+		delivery := &mm.deliveries[i]
+		subscription := mm.webhooks[delivery.WebhookSubscriptionId-1]
+		if delivery.Status == DELIVERY_PENDING && !delivery.NextAttemptAt.After(now) && !subscription.DeletedAt.Valid {
+			due = append(due, delivery)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(due[j].NextAttemptAt) })
+	if len(due) > size {
+		due = due[:size]
+	}
+	claimed := []WebhookDelivery{}
+	for _, delivery := range due {
+		delivery.NextAttemptAt = now.Add(lease)
+		copied := *delivery
+		copied.WebhookSubscription = mm.webhooks[delivery.WebhookSubscriptionId-1]
+		claimed = append(claimed, copied)
+	}
+	sort.Slice(claimed, func(i, j int) bool { return claimed[i].ID < claimed[j].ID })
+	return claimed, nil
+}
+
+func (mm *memoryMadden) CompleteWebhookDelivery(ctx context.Context, id uint, deliveredAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if id == 0 || id > uint(len(mm.deliveries)) {
+		return nil
+	}
+	delivery := &mm.deliveries[id-1]
+	delivery.Status = DELIVERY_DELIVERED
+	delivery.Attempts++
+	delivery.LastError = ""
+	delivery.DeliveredAt = &deliveredAt
+	delivery.UpdatedAt = time.Now()
+	return nil
+}
+
+func (mm *memoryMadden) FailWebhookDelivery(ctx context.Context, id uint, message string, retryAt *time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	if id == 0 || id > uint(len(mm.deliveries)) {
+		return nil
+	}
+	delivery := &mm.deliveries[id-1]
+	delivery.Status = DELIVERY_DEAD
+	delivery.Attempts++
+	delivery.LastError = message
+	delivery.UpdatedAt = time.Now()
+	if retryAt != nil {
+		delivery.Status = DELIVERY_PENDING
+		delivery.NextAttemptAt = *retryAt
+	}
+	return nil
+}
+
+func (mm *memoryMadden) GetLatestChangeEventId(ctx context.Context) (uint, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	if len(mm.changeEvents) == 0 {
+		return 0, nil
+	}
+	return mm.changeEvents[len(mm.changeEvents)-1].ID, nil
+}
+
+func (mm *memoryMadden) GetChangeEvents(ctx context.Context, afterId uint, size int) ([]ChangeEvent, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	events := []ChangeEvent{}
+	for _, event := range mm.changeEvents {
+		if len(events) == size {
+			break
+		}
+		if event.ID > afterId {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (mm *memoryMadden) ListenChangeEvents(ctx context.Context, notify chan<- struct{}) error {
+	mm.lock.Lock()
+	mm.listeners[notify] = true
+	mm.lock.Unlock()
+	defer func() {
+		mm.lock.Lock()
+		delete(mm.listeners, notify)
+		mm.lock.Unlock()
+	}()
+	select {
+	case notify <- struct{}{}:
+	default:
+	}
+	<-ctx.Done()
+	return contextError(ctx.Err())
+}
+
+func (mm *memoryMadden) PurgeChangeEvents(ctx context.Context, cutoff time.Time) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, contextError(err)
+	}
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	kept := []ChangeEvent{}
+	for _, event := range mm.changeEvents {
+		if !event.CreatedAt.Before(cutoff) {
+			kept = append(kept, event)
+		}
+	}
+	purged := int64(len(mm.changeEvents) - len(kept))
+	mm.changeEvents = kept
+	return purged, nil
+}
+
+func (mm *memoryMadden) HistoricizeMaddenItems(ctx context.Context, cutoff time.Time, actor string) (JobRun, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return JobRun{}, false, contextError(err)
+	}
+	//the write lock makes every caller the leader of its own run
+	mm.lock.Lock()
+	defer mm.lock.Unlock()
+	run := JobRun{Name: JOB_HISTORICIZE, StartedAt: time.Now(), Cutoff: cutoff}
+	ended := []*MaddenItem{}
+	for _, item := range mm.items {
+		if item.DeletedAt.Valid || item.IsHistorical {
+			continue
+		}
+		//recurring items end with their last occurrence, those recurring forever never end
+		if (item.RRule == "" && item.EndDate < cutoff.Unix()) || (item.RRule != "" && item.SeriesEnd != 0 && item.SeriesEnd < cutoff.Unix()) {
+			ended = append(ended, item)
+		}
+	}
+	sort.Slice(ended, func(i, j int) bool { return ended[i].ID < ended[j].ID })
+	saved := mm.copyData()
+	for _, item := range ended {
+		item.IsHistorical = true
+		item.Version++
+		item.UpdatedAt = time.Now()
+		if err := mm.recordRevision(mm.loadItem(item), REVISION_UPDATE, actor); err != nil {
+			mm.restoreData(saved)
+			return JobRun{}, false, &DbError{Message: "error while historicizing items", OriginalError: err}
+		}
+	}
+	run.FinishedAt = time.Now()
+	run.Changed = int64(len(ended))
+	run.TotalChanged = mm.jobRuns[JOB_HISTORICIZE].TotalChanged + run.Changed
+	mm.jobRuns[JOB_HISTORICIZE] = run
+	return run, true, nil
+}
+
+func (mm *memoryMadden) GetJobRun(ctx context.Context, name string) (JobRun, error) {
+	if err := ctx.Err(); err != nil {
+		return JobRun{}, contextError(err)
+	}
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	return mm.jobRuns[name], nil
+}
+
+//Implementation helpers
+
+//createSummary stores summary, announcing it if it differs from the current summary, callers must hold the write lock
+func (mm *memoryMadden) createSummary(summary Summary) (Summary, error) {
+	current := Summary{}
+	for i := len(mm.summaries) - 1; i >= 0; i-- {
+		if !mm.summaries[i].DeletedAt.Valid {
+			current = mm.summaries[i]
+			break
+		}
+	}
+	created := summary
+	created.Model = newModel(uint(len(mm.summaries) + 1))
+	mm.summaries = append(mm.summaries, created)
+	if err := mm.recordChangeEvent(summaryCreatedPayload(created)); err != nil {
+		return created, err
+	}
+	if current.ID != 0 && current.Summary == created.Summary {
+		return created, nil
+	}
+	return created, mm.enqueueWebhookEvent(summaryPayload(created))
+}
+
+//createPublished stores published, announcing it if it toggles the current state, callers must hold the write lock
+func (mm *memoryMadden) createPublished(published Published) (Published, error) {
+	current := Published{}
+	for i := len(mm.published) - 1; i >= 0; i-- {
+		if !mm.published[i].DeletedAt.Valid {
+			current = mm.published[i]
+			break
+		}
+	}
+	created := published
+	created.Model = newModel(uint(len(mm.published) + 1))
+	mm.published = append(mm.published, created)
+	if current.Published == created.Published {
+		return created, nil
+	}
+	if err := mm.recordChangeEvent(publishedPayload(created)); err != nil {
+		return created, err
+	}
+	return created, mm.enqueueWebhookEvent(publishedPayload(created))
+}
+
+//recordChangeEvent appends payload to the change event log and notifies listeners, callers must hold the write lock
+//listeners read the log under the lock, so they only see the event once the change announcing it is complete
+func (mm *memoryMadden) recordChangeEvent(payload WebhookPayload) error {
+	event, err := changeEvent(payload)
+	if err != nil {
+		return err
+	}
+	event.ID = mm.nextChangeEventId
+	mm.nextChangeEventId++
+	mm.changeEvents = append(mm.changeEvents, event)
+	for listener := range mm.listeners {
+		select {
+		case listener <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+//enqueueWebhookEvent appends a delivery of payload for every subscription to its event, callers must hold the write lock
+func (mm *memoryMadden) enqueueWebhookEvent(payload WebhookPayload) error {
+	deliveries, err := buildDeliveries(mm.liveWebhooks(), payload)
+	if err != nil {
+		return err
+	}
+	for _, delivery := range deliveries {
+		now := time.Now()
+		delivery.ID = uint(len(mm.deliveries) + 1)
+		delivery.CreatedAt = now
+		delivery.UpdatedAt = now
+		mm.deliveries = append(mm.deliveries, delivery)
+	}
+	return nil
+}
+
+//liveWebhooks returns the subscriptions that are not deleted ordered by id, callers must hold the lock
+func (mm *memoryMadden) liveWebhooks() []WebhookSubscription {
+	live := []WebhookSubscription{}
+	for _, subscription := range mm.webhooks {
+		if !subscription.DeletedAt.Valid {
+			live = append(live, subscription)
+		}
+	}
+	return live
+}
+
+//createItem stores a new item with its images and first revision, callers must hold the write lock
+func (mm *memoryMadden) createItem(item MaddenItem, actor string) (MaddenItem, error) {
+	if err := validateRecurrence(item); err != nil {
+		return MaddenItem{}, err
+	}
+	if existingId := mm.findDuplicateItem(item); existingId != 0 {
+		return MaddenItem{}, duplicateItemError(existingId)
+	}
+	id := item.ID
+	if id == 0 {
+		id = mm.nextItemId
+	}
+	if _, exists := mm.items[id]; exists {
+		return MaddenItem{}, &DbError{Message: "error during Item Creation", OriginalError: fmt.Errorf("duplicate key value violates unique constraint on id %d", id)}
+	}
+	if err := mm.validateItemImages(item.ItemImages); err != nil {
+		return MaddenItem{}, &DbError{Message: "error during Item Creation", OriginalError: err}
+	}
+	stored := item
+	stored.Model = newModel(id)
+	stored.Version = 1
+	stored.SeriesEnd = seriesEnd(item)
+	stored.ItemImages = nil
+	stored.Occurrences = nil
+	mm.items[id] = &stored
+	if id >= mm.nextItemId {
+		mm.nextItemId = id + 1
+	}
+	mm.insertItemImages(id, item.ItemImages)
+	created := mm.loadItem(&stored)
+	if err := mm.recordRevision(created, REVISION_CREATE, actor); err != nil {
+		return MaddenItem{}, err
+	}
+	return created, nil
+}
+
+//updateItem updates the item stored at expectedVersion and records its revision, callers must hold the write lock
+func (mm *memoryMadden) updateItem(item MaddenItem, expectedVersion uint, actor string) (MaddenItem, error) {
+	stored, exists := mm.items[item.ID]
+	if !exists || stored.DeletedAt.Valid {
+		return MaddenItem{}, &DbError{Message: "Error or item did not exist on update", OriginalError: gorm.ErrRecordNotFound}
+	}
+	if stored.Version != expectedVersion {
+		return MaddenItem{}, staleVersionError("item", item.ID, stored.Version)
+	}
+	if err := validateRecurrence(item); err != nil {
+		return MaddenItem{}, err
+	}
+	if existingId := mm.findDuplicateItem(item); existingId != 0 {
+		return MaddenItem{}, duplicateItemError(existingId)
+	}
+	if err := mm.validateItemImages(item.ItemImages); err != nil {
+		return MaddenItem{}, &DbError{Message: "error on update", OriginalError: err}
+	}
+	//mirrors the BeforeUpdate hook, existing associations are hard deleted and replaced
+	for id, itemImage := range mm.itemImages {
+		if itemImage.MaddenItemId == item.ID {
+			delete(mm.itemImages, id)
+		}
+	}
+	stored.BeginDate = item.BeginDate
+	stored.EndDate = item.EndDate
+	stored.Summary = item.Summary
+	stored.Details = item.Details
+	stored.IsHistorical = item.IsHistorical
+	stored.RRule = item.RRule
+	//exceptions of occurrences the updated series no longer has are dropped
+	stored.Occurrences = keptOccurrences(item, stored.Occurrences)
+	stored.SeriesEnd = seriesEnd(*stored)
+	stored.Version++
+	stored.UpdatedAt = time.Now()
+	mm.insertItemImages(item.ID, item.ItemImages)
+	updated := mm.loadItem(stored)
+	if err := mm.recordRevision(updated, REVISION_UPDATE, actor); err != nil {
+		return MaddenItem{}, err
+	}
+	return updated, nil
+}
+
+//findOccurrences expands the live recurring items matching historic into their occurrences within the dates that keep returns true for, callers must hold the lock
+func (mm *memoryMadden) findOccurrences(startDate, endDate int64, historic bool, keep func(occurrence MaddenItem) bool, limit int) ([]MaddenItem, error) {
+	series := []MaddenItem{}
+	for _, item := range mm.items {
+		if !item.DeletedAt.Valid && item.RRule != "" && (item.SeriesEnd == 0 || item.SeriesEnd > endDate) && item.IsHistorical == historic {
+			series = append(series, mm.loadItem(item))
+		}
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].ID < series[j].ID })
+	return expandAllSeries(series, startDate, endDate, keep, limit)
+}
+
+//checkItemConflicts returns the conflicts of item as written with other live items, see writeConflicts
+//callers must hold the write lock, so no other write is checked until the item is written
+func (mm *memoryMadden) checkItemConflicts(item MaddenItem, strict bool) ([]ItemConflict, error) {
+	windows, _, _, err := candidateWindows(item)
+	if err != nil {
+		return nil, err
+	}
+	return writeConflicts(windows, strict, mm.findWindows)
+}
+
+//findWindows returns the windows of live items between from and to, see itemWindows
+//if imageIds is not nil only items booking one of the image files are read, callers must hold the lock
+func (mm *memoryMadden) findWindows(from, to int64, imageIds []uint) ([]MaddenItem, error) {
+	booked := map[uint]bool{}
+	for _, id := range imageIds {
+		booked[id] = true
+	}
+	items := []MaddenItem{}
+	for _, item := range mm.items {
+		if item.DeletedAt.Valid {
+			continue
+		}
+		loaded := mm.loadItem(item)
+		if imageIds == nil || booksAny(loaded, booked) {
+			items = append(items, loaded)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return itemWindows(items, from, to)
+}
+
+//booksAny returns true if item books one of the image files in booked
+func booksAny(item MaddenItem, booked map[uint]bool) bool {
+	for _, id := range itemImageIds(item) {
+		if booked[id] {
+			return true
+		}
+	}
+	return false
+}
+
+//findDuplicateItem returns the id of a non deleted madden item other than item with identical fields, 0 if there is none
+//this mirrors the unique content index, callers must hold the lock
+func (mm *memoryMadden) findDuplicateItem(item MaddenItem) uint {
+	for _, existing := range mm.items {
+		if existing.DeletedAt.Valid || existing.ID == item.ID {
+			continue
+		}
+		if existing.EndDate == item.EndDate && existing.BeginDate == item.BeginDate && existing.Summary == item.Summary && existing.Details == item.Details {
+			return existing.ID
+		}
+	}
+	return 0
+}
+
+//validateItemImages mirrors the item_images foreign key and the live image check, every referenced image must exist and not be deleted
+func (mm *memoryMadden) validateItemImages(itemImages []ItemImages) error {
+	for _, itemImage := range itemImages {
+		imageId := itemImageFileId(itemImage)
+		if image, exists := mm.images[imageId]; !exists || image.DeletedAt.Valid {
+			return fmt.Errorf("image %d does not exist", imageId)
+		}
+	}
+	return nil
+}
+
+//imageUsageIds returns the ids of live items linking the image with id in ascending order, callers must hold the lock
+func (mm *memoryMadden) imageUsageIds(id uint) []uint {
+	itemIds := []uint{}
+	found := map[uint]bool{}
+	for _, itemImage := range mm.itemImages {
+		if itemImage.MaddenImageFileId != id || itemImage.DeletedAt.Valid || found[itemImage.MaddenItemId] {
+			continue
+		}
+		if item, exists := mm.items[itemImage.MaddenItemId]; exists && !item.DeletedAt.Valid {
+			found[item.ID] = true
+			itemIds = append(itemIds, item.ID)
+		}
+	}
+	sort.Slice(itemIds, func(i, j int) bool { return itemIds[i] < itemIds[j] })
+	return itemIds
+}
+
+//reviseItemImages records a change to the image links of the live item with itemId, callers must hold the write lock
+func (mm *memoryMadden) reviseItemImages(itemId uint, actor string) error {
+	item, exists := mm.items[itemId]
+	if !exists || item.DeletedAt.Valid {
+		return nil
+	}
+	item.Version++
+	item.UpdatedAt = time.Now()
+	return mm.recordRevision(mm.loadItem(item), REVISION_UPDATE, actor)
+}
+
+//findDanglingItemImages mirrors the postgres dangling item image query, callers must hold the lock
+func (mm *memoryMadden) findDanglingItemImages() []ItemImages {
+	dangling := []ItemImages{}
+	for _, itemImage := range mm.itemImages {
+		if itemImage.DeletedAt.Valid {
+			continue
+		}
+		item, itemExists := mm.items[itemImage.MaddenItemId]
+		image, imageExists := mm.images[itemImage.MaddenImageFileId]
+		if !itemExists || !imageExists || (!item.DeletedAt.Valid && image.DeletedAt.Valid) {
+			dangling = append(dangling, *itemImage)
+		}
+	}
+	sort.Slice(dangling, func(i, j int) bool { return dangling[i].ID < dangling[j].ID })
+	return dangling
+}
+
+//insertItemImages stores new associations between the item with itemId and the passed images, callers must validate images first
+func (mm *memoryMadden) insertItemImages(itemId uint, itemImages []ItemImages) {
+	for _, itemImage := range itemImages {
+		stored := ItemImages{
+			Model:             newModel(mm.nextItemImageId),
+			Status:            itemImage.Status,
+			MaddenItemId:      itemId,
+			MaddenImageFileId: itemImageFileId(itemImage),
+		}
+		mm.itemImages[stored.ID] = &stored
+		mm.nextItemImageId++
+	}
+}
+
+//imageConstraintsValid mirrors the unique constraints on image file name and thumbnail, which include soft deleted rows
+func (mm *memoryMadden) imageConstraintsValid(id uint, image MaddenImageFile, create bool) error {
+	if _, exists := mm.images[id]; exists && create {
+		return fmt.Errorf("duplicate key value violates unique constraint on id %d", id)
+	}
+	for existingId, existing := range mm.images {
+		if existingId == id {
+			continue
+		}
+		if image.FileName != "" && existing.FileName == image.FileName {
+			return fmt.Errorf("duplicate key value violates unique constraint on file_name %s", image.FileName)
+		}
+		if image.Thumbnail != "" && existing.Thumbnail == image.Thumbnail {
+			return fmt.Errorf("duplicate key value violates unique constraint on thumbnail %s", image.Thumbnail)
+		}
+		if image.ContentHash != "" && existing.ContentHash == image.ContentHash {
+			return fmt.Errorf("duplicate key value violates unique constraint on content_hash %s", image.ContentHash)
+		}
+	}
+	return nil
+}
+
+//createImage stores a new image, callers must hold the lock
+func (mm *memoryMadden) createImage(image MaddenImageFile) (MaddenImageFile, error) {
+	inserted := image
+	for _, existing := range mm.images {
+		if !existing.DeletedAt.Valid && existing.FileName == image.FileName {
+			return inserted, duplicateImageError(*existing)
+		}
+	}
+	id := image.ID
+	if id == 0 {
+		id = mm.nextImageId
+	}
+	if err := mm.imageConstraintsValid(id, image, true); err != nil {
+		return inserted, &DbError{Message: "error while inserting image into database", OriginalError: err}
+	}
+	inserted.Model = newModel(id)
+	inserted.Version = 1
+	stored := inserted
+	mm.images[id] = &stored
+	if id >= mm.nextImageId {
+		mm.nextImageId = id + 1
+	}
+	return inserted, nil
+}
+
+//loadItem returns a copy of item with its images and exceptions preloaded, callers must hold the lock
+func (mm *memoryMadden) loadItem(item *MaddenItem) MaddenItem {
+	loaded := *item
+	loaded.Occurrences = append([]ItemOccurrence{}, item.Occurrences...)
+	sort.Slice(loaded.Occurrences, func(i, j int) bool {
+		return loaded.Occurrences[i].OccurrenceStart < loaded.Occurrences[j].OccurrenceStart
+	})
+	loaded.ItemImages = []ItemImages{}
+	for _, itemImage := range mm.itemImages {
+		if itemImage.MaddenItemId != item.ID || itemImage.DeletedAt.Valid {
+			continue
+		}
+		preloaded := *itemImage
+		//preloading skips soft deleted images leaving the zero value
+		if image, exists := mm.images[itemImage.MaddenImageFileId]; exists && !image.DeletedAt.Valid {
+			preloaded.MaddenImageFile = *image
+		}
+		loaded.ItemImages = append(loaded.ItemImages, preloaded)
+	}
+	sort.Slice(loaded.ItemImages, func(i, j int) bool { return loaded.ItemImages[i].ID < loaded.ItemImages[j].ID })
+	return loaded
+}
+
+//findImages returns a page of non deleted images matching filter in creation order
+func (mm *memoryMadden) findImages(pageNum, size int, filter func(image *MaddenImageFile) bool) []MaddenImageFile {
+	mm.lock.RLock()
+	defer mm.lock.RUnlock()
+	matched := []*MaddenImageFile{}
+	for _, image := range mm.images {
+		if !image.DeletedAt.Valid && filter(image) {
+			matched = append(matched, image)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return imageLess(matched[i], matched[j]) })
+	images := []MaddenImageFile{}
+	start, end := pageBounds(len(matched), pageNum, size)
+	for _, image := range matched[start:end] {
+		images = append(images, *image)
+	}
+	return images
+}
+
+//itemLess orders items the same way itemOrderString does, falling back to id for stable pages
+func itemLess(a, b *MaddenItem, sortField SortField) bool {
+	first, second := []int64{a.BeginDate, a.EndDate}, []int64{b.BeginDate, b.EndDate}
+	if sortField != StartDate {
+		first, second = []int64{a.EndDate, a.BeginDate}, []int64{b.EndDate, b.BeginDate}
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			return first[i] < second[i]
+		}
+	}
+	return a.ID < b.ID
+}
+
+//imageLess orders images the same way imageOrderString does, falling back to id for stable pages
+func imageLess(a, b *MaddenImageFile) bool {
+	if a.CreatedAt.Equal(b.CreatedAt) {
+		return a.ID < b.ID
+	}
+	return a.CreatedAt.Before(b.CreatedAt)
+}
+
+//deletedLess orders soft deleted rows most recently deleted first, falling back to id for stable pages
+func deletedLess(a, b gorm.Model) bool {
+	if a.DeletedAt.Time.Equal(b.DeletedAt.Time) {
+		return a.ID > b.ID
+	}
+	return a.DeletedAt.Time.After(b.DeletedAt.Time)
+}
+
+//pageBounds returns the slice bounds of a page offset by pageNum and size within a collection of length total
+func pageBounds(total, pageNum, size int) (int, int) {
+	start, end := pageNum*size, (pageNum+1)*size
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+//itemImageFileId returns the image id an item image refers to, either directly or through the association
+func itemImageFileId(itemImage ItemImages) uint {
+	if itemImage.MaddenImageFileId != 0 {
+		return itemImage.MaddenImageFileId
+	}
+	return itemImage.MaddenImageFile.ID
+}
+
+//newModel builds a gorm model with the passed id and current audit timestamps
+func newModel(id uint) gorm.Model {
+	now := time.Now()
+	return gorm.Model{ID: id, CreatedAt: now, UpdatedAt: now}
+}
+
+//recordRevision appends the current state of item as its next revision, callers must hold the write lock
+func (mm *memoryMadden) recordRevision(item MaddenItem, action, actor string) error {
+	var previous *ItemRevision
+	for i := len(mm.revisions) - 1; i >= 0; i-- {
+		if mm.revisions[i].MaddenItemId == item.ID {
+			previous = &mm.revisions[i]
+			break
+		}
+	}
+	revision, err := buildRevision(item.ID, action, actor, previous, SnapshotItem(item))
+	if err != nil {
+		return err
+	}
+	revision.ID = uint(len(mm.revisions) + 1)
+	revision.CreatedAt = time.Now()
+	mm.revisions = append(mm.revisions, revision)
+	payload, err := entryPayload(item, revision)
+	if err != nil {
+		return err
+	}
+	if err := mm.recordChangeEvent(payload); err != nil {
+		return err
+	}
+	return mm.enqueueWebhookEvent(payload)
+}
+
+//copyData returns a deep copy of the stored data, callers must hold the lock
+func (mm *memoryMadden) copyData() *memoryMadden {
+	copied := &memoryMadden{
+		summaries:         append([]Summary{}, mm.summaries...),
+		published:         append([]Published{}, mm.published...),
+		items:             map[uint]*MaddenItem{},
+		images:            map[uint]*MaddenImageFile{},
+		itemImages:        map[uint]*ItemImages{},
+		revisions:         append([]ItemRevision{}, mm.revisions...),
+		webhooks:          append([]WebhookSubscription{}, mm.webhooks...),
+		deliveries:        append([]WebhookDelivery{}, mm.deliveries...),
+		changeEvents:      append([]ChangeEvent{}, mm.changeEvents...),
+		nextItemId:        mm.nextItemId,
+		nextImageId:       mm.nextImageId,
+		nextItemImageId:   mm.nextItemImageId,
+		nextChangeEventId: mm.nextChangeEventId,
+		nextOccurrenceId:  mm.nextOccurrenceId,
+	}
+	for id, item := range mm.items {
+		stored := *item
+		stored.Occurrences = append([]ItemOccurrence{}, item.Occurrences...)
+		copied.items[id] = &stored
+	}
+	for id, image := range mm.images {
+		stored := *image
+		copied.images[id] = &stored
+	}
+	for id, itemImage := range mm.itemImages {
+		stored := *itemImage
+		copied.itemImages[id] = &stored
+	}
+	return copied
+}
+
+//restoreData replaces the stored data with data copied by copyData, callers must hold the write lock
+func (mm *memoryMadden) restoreData(data *memoryMadden) {
+	mm.summaries = data.summaries
+	mm.published = data.published
+	mm.items = data.items
+	mm.images = data.images
+	mm.itemImages = data.itemImages
+	mm.revisions = data.revisions
+	mm.webhooks = data.webhooks
+	mm.deliveries = data.deliveries
+	mm.changeEvents = data.changeEvents
+	mm.nextItemId = data.nextItemId
+	mm.nextImageId = data.nextImageId
+	mm.nextItemImageId = data.nextItemImageId
+	mm.nextChangeEventId = data.nextChangeEventId
+	mm.nextOccurrenceId = data.nextOccurrenceId
+}
+
+//memoryImportStore applies an import to a memoryMadden whose write lock is held
+type memoryImportStore struct {
+	mm *memoryMadden
+}
+
+func (store *memoryImportStore) findImageByName(fileName string) (*MaddenImageFile, error) {
+	for _, image := range store.mm.images {
+		if image.FileName == fileName {
+			found := *image
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (store *memoryImportStore) createImage(image MaddenImageFile) (MaddenImageFile, error) {
+	return store.mm.createImage(image)
+}
+
+func (store *memoryImportStore) updateImageThumbnail(id uint, thumbnail string) error {
+	stored, exists := store.mm.images[id]
+	if !exists {
+		return &DbError{Message: fmt.Sprintf("image with id %d did not exist", id)}
+	}
+	if err := store.mm.imageConstraintsValid(id, MaddenImageFile{Thumbnail: thumbnail}, false); err != nil {
+		return &DbError{Message: fmt.Sprintf("error while updating image with id %d", id), OriginalError: err}
+	}
+	stored.Thumbnail = thumbnail
+	stored.Version++
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+func (store *memoryImportStore) findDuplicateItem(item MaddenItem) (uint, error) {
+	return store.mm.findDuplicateItem(item), nil
+}
+
+func (store *memoryImportStore) getItem(id uint) (MaddenItem, error) {
+	item, exists := store.mm.items[id]
+	if !exists || item.DeletedAt.Valid {
+		return MaddenItem{}, &DbError{Message: fmt.Sprintf("item with ID: %d did not exist", id), OriginalError: gorm.ErrRecordNotFound}
+	}
+	return store.mm.loadItem(item), nil
+}
+
+func (store *memoryImportStore) createItem(item MaddenItem, actor string) (MaddenItem, error) {
+	return store.mm.createItem(item, actor)
+}
+
+func (store *memoryImportStore) updateItem(item MaddenItem, expectedVersion uint, actor string) (MaddenItem, error) {
+	return store.mm.updateItem(item, expectedVersion, actor)
+}
+
+func (store *memoryImportStore) currentSummary() (Summary, error) {
+	for i := len(store.mm.summaries) - 1; i >= 0; i-- {
+		if !store.mm.summaries[i].DeletedAt.Valid {
+			return store.mm.summaries[i], nil
+		}
+	}
+	return Summary{}, nil
+}
+
+func (store *memoryImportStore) createSummary(summary Summary) error {
+	_, err := store.mm.createSummary(summary)
+	return err
+}
+
+func (store *memoryImportStore) currentPublished() (Published, error) {
+	for i := len(store.mm.published) - 1; i >= 0; i-- {
+		if !store.mm.published[i].DeletedAt.Valid {
+			return store.mm.published[i], nil
+		}
+	}
+	return Published{}, nil
+}
+
+func (store *memoryImportStore) createPublished(published Published) error {
+	_, err := store.mm.createPublished(published)
+	return err
+}
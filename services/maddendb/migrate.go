@@ -0,0 +1,279 @@
+package maddendb
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//defines and implements versioned schema migrations
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+const (
+	//migration lock id, arbitrary but must be unique across any advisory locks taken against the database
+	migrationLockId = 72706
+	UP_SUFFIX       = "up"
+	DOWN_SUFFIX     = "down"
+)
+
+//migration file names must look like 0001_some_name.up.sql
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+//Migration is a single ordered schema change with the sql to apply and to roll it back
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+//SchemaMigration records a migration applied to the database
+type SchemaMigration struct {
+	Version   uint `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+//MigrationState describes a known or applied migration and whether it has been applied
+type MigrationState struct {
+	Version uint
+	Name    string
+	//nil if the migration is pending
+	AppliedAt *time.Time
+	//true if the migration is recorded in the database but unknown to this binary
+	Unknown bool
+}
+
+//Migrator defines an interface to apply, roll back and inspect versioned schema migrations
+type Migrator interface {
+	//MigrateUp applies every pending migration in order, each in its own transaction
+	MigrateUp() ([]Migration, error)
+	//MigrateDown rolls back the most recently applied steps migrations, most recent first
+	MigrateDown(steps int) ([]Migration, error)
+	//MigrationStatus returns every known and applied migration ordered by version
+	MigrationStatus() ([]MigrationState, error)
+	//CheckSchemaVersion returns an error if the database schema is ahead of or behind the migrations known to this binary
+	CheckSchemaVersion() error
+}
+
+//postgres backed implementation of Migrator
+type postgresMigrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+//postgres migrator constructor, returns an error if the embedded migrations are malformed
+func NewPostgresMigrator(db *gorm.DB) (Migrator, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	return &postgresMigrator{db: db, migrations: migrations}, nil
+}
+
+//Interface implementation
+
+func (pm *postgresMigrator) MigrateUp() ([]Migration, error) {
+	applied := []Migration{}
+	if err := pm.ensureVersionTable(); err != nil {
+		return applied, err
+	}
+	for _, migration := range pm.migrations {
+		ran := false
+		err := pm.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockId).Error; err != nil {
+				return err
+			}
+			//checked under the lock so concurrent migrators never apply the same version twice
+			var count int64
+			if err := tx.Model(&SchemaMigration{}).Where("version = ?", migration.Version).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			ran = true
+			return tx.Create(&SchemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now().UTC()}).Error
+		})
+		if err != nil {
+			return applied, &DbError{Message: fmt.Sprintf("error applying migration %04d_%s", migration.Version, migration.Name), OriginalError: err}
+		}
+		if ran {
+			applied = append(applied, migration)
+		}
+	}
+	return applied, nil
+}
+
+func (pm *postgresMigrator) MigrateDown(steps int) ([]Migration, error) {
+	rolledBack := []Migration{}
+	if err := pm.ensureVersionTable(); err != nil {
+		return rolledBack, err
+	}
+	for i := 0; i < steps; i++ {
+		var rolled *Migration
+		err := pm.db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockId).Error; err != nil {
+				return err
+			}
+			latest := SchemaMigration{}
+			if err := tx.Order("version desc").Take(&latest).Error; err != nil {
+				if err == gorm.ErrRecordNotFound {
+					return nil
+				}
+				return err
+			}
+			migration, known := pm.find(latest.Version)
+			if !known {
+				return fmt.Errorf("migration %d is unknown to this binary and can not be rolled back", latest.Version)
+			}
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return err
+			}
+			rolled = &migration
+			return tx.Delete(&SchemaMigration{}, latest.Version).Error
+		})
+		if err != nil {
+			return rolledBack, &DbError{Message: "error rolling back migration", OriginalError: err}
+		}
+		if rolled == nil {
+			break
+		}
+		rolledBack = append(rolledBack, *rolled)
+	}
+	return rolledBack, nil
+}
+
+func (pm *postgresMigrator) MigrationStatus() ([]MigrationState, error) {
+	applied, err := pm.appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+	states := map[uint]MigrationState{}
+	for _, migration := range pm.migrations {
+		states[migration.Version] = MigrationState{Version: migration.Version, Name: migration.Name}
+	}
+	for _, record := range applied {
+		appliedAt := record.AppliedAt
+		state, known := states[record.Version]
+		if !known {
+			state = MigrationState{Version: record.Version, Name: record.Name, Unknown: true}
+		}
+		state.AppliedAt = &appliedAt
+		states[record.Version] = state
+	}
+	ordered := []MigrationState{}
+	for _, state := range states {
+		ordered = append(ordered, state)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].Version < ordered[j].Version })
+	return ordered, nil
+}
+
+func (pm *postgresMigrator) CheckSchemaVersion() error {
+	states, err := pm.MigrationStatus()
+	if err != nil {
+		return err
+	}
+	for _, state := range states {
+		if state.Unknown {
+			return &DbError{Message: "database schema is ahead of this binary", OriginalError: fmt.Errorf("migration %04d_%s is unknown, deploy a newer binary or run migrate down from one", state.Version, state.Name)}
+		}
+	}
+	for _, state := range states {
+		if state.AppliedAt == nil {
+			return &DbError{Message: "database schema is behind this binary", OriginalError: fmt.Errorf("migration %04d_%s is pending, run migrate up", state.Version, state.Name)}
+		}
+	}
+	return nil
+}
+
+//Implementation helpers
+
+//ensureVersionTable creates the schema_migrations table if it does not exist
+func (pm *postgresMigrator) ensureVersionTable() error {
+	if err := pm.db.Exec("CREATE TABLE IF NOT EXISTS schema_migrations (version bigint PRIMARY KEY, name text NOT NULL, applied_at timestamptz NOT NULL)").Error; err != nil {
+		return &DbError{Message: "error creating schema version table", OriginalError: err}
+	}
+	return nil
+}
+
+//appliedMigrations returns every migration recorded in the database, an empty slice if none have been recorded
+func (pm *postgresMigrator) appliedMigrations() ([]SchemaMigration, error) {
+	applied := []SchemaMigration{}
+	if !pm.db.Migrator().HasTable(&SchemaMigration{}) {
+		return applied, nil
+	}
+	if err := pm.db.Order("version asc").Find(&applied).Error; err != nil {
+		return nil, &DbError{Message: "error reading schema version table", OriginalError: err}
+	}
+	return applied, nil
+}
+
+//find returns the known migration with version
+func (pm *postgresMigrator) find(version uint) (Migration, bool) {
+	for _, migration := range pm.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+//LoadMigrations returns the migrations embedded in this binary ordered by version
+//every version must have both an up and a down file and versions must start at 1 with no gaps
+func LoadMigrations() ([]Migration, error) {
+	files, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[uint]*Migration{}
+	for _, file := range files {
+		matches := migrationFileName.FindStringSubmatch(file.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("migration file %s does not match the expected 0001_name.up.sql format", file.Name())
+		}
+		version, _ := strconv.ParseUint(matches[1], 10, 32)
+		contents, err := migrationFiles.ReadFile("migrations/" + file.Name())
+		if err != nil {
+			return nil, err
+		}
+		migration, exists := byVersion[uint(version)]
+		if !exists {
+			migration = &Migration{Version: uint(version), Name: matches[2]}
+			byVersion[uint(version)] = migration
+		}
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %d has conflicting names %s and %s", version, migration.Name, matches[2])
+		}
+		switch matches[3] {
+		case UP_SUFFIX:
+			migration.Up = string(contents)
+		case DOWN_SUFFIX:
+			migration.Down = string(contents)
+		}
+	}
+	migrations := []Migration{}
+	for version := uint(1); version <= uint(len(byVersion)); version++ {
+		migration, exists := byVersion[version]
+		if !exists {
+			return nil, fmt.Errorf("migration versions must be contiguous from 1, version %d is missing", version)
+		}
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s must have both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	return migrations, nil
+}
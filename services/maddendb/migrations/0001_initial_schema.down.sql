@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS item_images;
+DROP TABLE IF EXISTS madden_items;
+DROP TABLE IF EXISTS madden_image_files;
+DROP TABLE IF EXISTS summaries;
+DROP TABLE IF EXISTS publisheds;
@@ -0,0 +1,54 @@
+-- initial madden schema, matches the tables previously built by AutoMigrate so existing databases can adopt versioned migrations
+CREATE TABLE IF NOT EXISTS madden_image_files (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	updated_at timestamptz,
+	deleted_at timestamptz,
+	file_name varchar(500) NOT NULL UNIQUE,
+	thumbnail varchar(500) NOT NULL UNIQUE
+);
+CREATE INDEX IF NOT EXISTS idx_madden_image_files_deleted_at ON madden_image_files (deleted_at);
+
+CREATE TABLE IF NOT EXISTS madden_items (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	updated_at timestamptz,
+	deleted_at timestamptz,
+	begin_date bigint,
+	end_date bigint,
+	summary text,
+	details text,
+	is_historical boolean
+);
+CREATE INDEX IF NOT EXISTS idx_madden_items_deleted_at ON madden_items (deleted_at);
+
+CREATE TABLE IF NOT EXISTS item_images (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	updated_at timestamptz,
+	deleted_at timestamptz,
+	status text NOT NULL,
+	madden_item_id bigint,
+	madden_image_file_id bigint,
+	CONSTRAINT fk_madden_items_item_images FOREIGN KEY (madden_item_id) REFERENCES madden_items (id),
+	CONSTRAINT fk_item_images_madden_image_file FOREIGN KEY (madden_image_file_id) REFERENCES madden_image_files (id)
+);
+CREATE INDEX IF NOT EXISTS idx_item_images_deleted_at ON item_images (deleted_at);
+
+CREATE TABLE IF NOT EXISTS summaries (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	updated_at timestamptz,
+	deleted_at timestamptz,
+	summary text NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_summaries_deleted_at ON summaries (deleted_at);
+
+CREATE TABLE IF NOT EXISTS publisheds (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	updated_at timestamptz,
+	deleted_at timestamptz,
+	published boolean
+);
+CREATE INDEX IF NOT EXISTS idx_publisheds_deleted_at ON publisheds (deleted_at);
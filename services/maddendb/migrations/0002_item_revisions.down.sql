@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS item_revisions;
+DROP FUNCTION IF EXISTS item_revisions_immutable();
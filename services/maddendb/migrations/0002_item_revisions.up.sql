@@ -0,0 +1,21 @@
+-- immutable revision history of madden items, rows are only ever inserted
+CREATE TABLE item_revisions (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz NOT NULL,
+	madden_item_id bigint NOT NULL,
+	revision bigint NOT NULL,
+	action text NOT NULL,
+	actor text NOT NULL,
+	snapshot text NOT NULL,
+	changes text NOT NULL,
+	CONSTRAINT uq_item_revisions_item_revision UNIQUE (madden_item_id, revision)
+);
+
+CREATE FUNCTION item_revisions_immutable() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'item revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_item_revisions_immutable BEFORE UPDATE OR DELETE ON item_revisions
+	FOR EACH ROW EXECUTE FUNCTION item_revisions_immutable();
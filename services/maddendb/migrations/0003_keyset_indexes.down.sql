@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_madden_image_files_created_id;
+DROP INDEX IF EXISTS idx_madden_items_end_begin_id;
+DROP INDEX IF EXISTS idx_madden_items_begin_end_id;
@@ -0,0 +1,4 @@
+-- indexes matching the keyset pagination orders of madden items and images
+CREATE INDEX idx_madden_items_begin_end_id ON madden_items (begin_date, end_date, id);
+CREATE INDEX idx_madden_items_end_begin_id ON madden_items (end_date, begin_date, id);
+CREATE INDEX idx_madden_image_files_created_id ON madden_image_files (created_at, id);
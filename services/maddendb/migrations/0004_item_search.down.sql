@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_madden_items_search_vector;
+ALTER TABLE madden_items DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,6 @@
+-- full text search over madden item summaries and details, summary matches rank above details matches
+ALTER TABLE madden_items ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+	setweight(to_tsvector('english', coalesce(summary, '')), 'A') ||
+	setweight(to_tsvector('english', coalesce(details, '')), 'B')
+) STORED;
+CREATE INDEX idx_madden_items_search_vector ON madden_items USING GIN (search_vector);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_madden_items_content;
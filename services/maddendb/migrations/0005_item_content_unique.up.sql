@@ -0,0 +1,17 @@
+-- at most one non deleted madden item may hold the same window, summary and details
+-- existing duplicates are not resolved here, the migration fails listing them so they can be deleted or changed before it is run again
+DO $$
+DECLARE
+	duplicates text;
+BEGIN
+	SELECT string_agg(format('%s (copy of %s)', id, original), ', ' ORDER BY id) INTO duplicates FROM (
+		SELECT id, first_value(id) OVER copies AS original, row_number() OVER copies AS copy
+		FROM madden_items WHERE deleted_at IS NULL
+		WINDOW copies AS (PARTITION BY begin_date, end_date, md5(coalesce(summary, '')), md5(coalesce(details, '')) ORDER BY id)
+	) items WHERE copy > 1;
+	IF duplicates IS NOT NULL THEN
+		RAISE EXCEPTION 'madden items duplicate the window, summary and details of an earlier item, delete or change them and migrate again: %', duplicates;
+	END IF;
+END
+$$;
+CREATE UNIQUE INDEX idx_madden_items_content ON madden_items (begin_date, end_date, md5(coalesce(summary, '')), md5(coalesce(details, ''))) WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE madden_image_files DROP COLUMN version;
+ALTER TABLE madden_items DROP COLUMN version;
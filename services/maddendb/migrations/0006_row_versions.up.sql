@@ -0,0 +1,3 @@
+-- optimistic concurrency versions, every update of an item or image increments its version
+ALTER TABLE madden_items ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE madden_image_files ADD COLUMN version bigint NOT NULL DEFAULT 1;
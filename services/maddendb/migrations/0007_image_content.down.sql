@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_madden_image_files_content_hash;
+ALTER TABLE madden_image_files DROP COLUMN height;
+ALTER TABLE madden_image_files DROP COLUMN width;
+ALTER TABLE madden_image_files DROP COLUMN mime_type;
+ALTER TABLE madden_image_files DROP COLUMN size;
+ALTER TABLE madden_image_files DROP COLUMN content_hash;
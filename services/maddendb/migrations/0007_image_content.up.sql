@@ -0,0 +1,8 @@
+-- content addressed images, uploads are identified by the SHA-256 of their content
+-- images registered by name alone keep an empty hash and are left out of the unique index
+ALTER TABLE madden_image_files ADD COLUMN content_hash varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE madden_image_files ADD COLUMN size bigint NOT NULL DEFAULT 0;
+ALTER TABLE madden_image_files ADD COLUMN mime_type varchar(100) NOT NULL DEFAULT '';
+ALTER TABLE madden_image_files ADD COLUMN width integer NOT NULL DEFAULT 0;
+ALTER TABLE madden_image_files ADD COLUMN height integer NOT NULL DEFAULT 0;
+CREATE UNIQUE INDEX idx_madden_image_files_content_hash ON madden_image_files (content_hash) WHERE content_hash <> '';
@@ -0,0 +1,28 @@
+-- webhook subscriptions and their outbox, deliveries are inserted in the same transaction as the change they announce
+CREATE TABLE webhook_subscriptions (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	updated_at timestamptz,
+	deleted_at timestamptz,
+	url text NOT NULL,
+	secret text NOT NULL,
+	events text NOT NULL DEFAULT ''
+);
+CREATE INDEX idx_webhook_subscriptions_deleted_at ON webhook_subscriptions (deleted_at);
+
+CREATE TABLE webhook_deliveries (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	updated_at timestamptz,
+	webhook_subscription_id bigint NOT NULL REFERENCES webhook_subscriptions (id),
+	event text NOT NULL,
+	payload text NOT NULL,
+	status text NOT NULL,
+	attempts bigint NOT NULL DEFAULT 0,
+	next_attempt_at timestamptz NOT NULL,
+	last_error text NOT NULL DEFAULT '',
+	delivered_at timestamptz
+);
+-- due pending deliveries are claimed in next attempt order
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries (webhook_subscription_id, id);
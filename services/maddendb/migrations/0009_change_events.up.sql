@@ -0,0 +1,9 @@
+-- the change event log streamed to live clients, rows are written in the same transaction as the change they announce
+CREATE TABLE change_events (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	event text NOT NULL,
+	payload text NOT NULL
+);
+-- old events are purged by age
+CREATE INDEX idx_change_events_created_at ON change_events (created_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_madden_items_current_end_date;
+DROP TABLE IF EXISTS job_runs;
@@ -0,0 +1,11 @@
+-- the latest run of each background job, written by whichever instance ran it
+CREATE TABLE job_runs (
+	name text PRIMARY KEY,
+	started_at timestamptz,
+	finished_at timestamptz,
+	cutoff timestamptz,
+	changed bigint NOT NULL DEFAULT 0,
+	total_changed bigint NOT NULL DEFAULT 0
+);
+-- ended items not yet historical are found by end date
+CREATE INDEX idx_madden_items_current_end_date ON madden_items (end_date) WHERE is_historical IS NOT TRUE AND deleted_at IS NULL;
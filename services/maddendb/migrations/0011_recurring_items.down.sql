@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS item_occurrences;
+DROP INDEX IF EXISTS idx_madden_items_recurring;
+ALTER TABLE madden_items DROP COLUMN IF EXISTS series_end;
+ALTER TABLE madden_items DROP COLUMN IF EXISTS rrule;
@@ -0,0 +1,19 @@
+-- recurring items repeat by an RFC 5545 rule, series_end bounds the series so ended series are skipped without expanding them
+ALTER TABLE madden_items ADD COLUMN rrule text NOT NULL DEFAULT '';
+ALTER TABLE madden_items ADD COLUMN series_end bigint NOT NULL DEFAULT 0;
+CREATE INDEX idx_madden_items_recurring ON madden_items (series_end) WHERE rrule <> '' AND deleted_at IS NULL;
+
+-- edited and cancelled occurrences, removed along with their item when it is purged
+CREATE TABLE item_occurrences (
+	id bigserial PRIMARY KEY,
+	created_at timestamptz,
+	updated_at timestamptz,
+	madden_item_id bigint NOT NULL REFERENCES madden_items (id) ON DELETE CASCADE,
+	occurrence_start bigint NOT NULL,
+	cancelled boolean NOT NULL DEFAULT false,
+	begin_date bigint NOT NULL DEFAULT 0,
+	end_date bigint NOT NULL DEFAULT 0,
+	summary text NOT NULL DEFAULT '',
+	details text NOT NULL DEFAULT '',
+	CONSTRAINT uq_item_occurrences_start UNIQUE (madden_item_id, occurrence_start)
+);
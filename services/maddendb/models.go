@@ -1,6 +1,8 @@
 package maddendb
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -11,6 +13,17 @@ type MaddenImageFile struct {
 	FileName string `gorm:"size:500;unique;not null"`
 	//name of the thumbnail
 	Thumbnail string `gorm:"size:500;unique;not null"`
+	//incremented on every update, updates must name the version they replace
+	Version uint `gorm:"not null;default:1"`
+	//hex encoded SHA-256 of the image content, unique when set, empty for images registered without their content
+	ContentHash string `gorm:"size:64;not null;default:''"`
+	//size of the image content in bytes
+	Size int64 `gorm:"not null;default:0"`
+	//sniffed MIME type of the image content
+	MimeType string `gorm:"size:100;not null;default:''"`
+	//pixel dimensions of the image
+	Width  int `gorm:"not null;default:0"`
+	Height int `gorm:"not null;default:0"`
 }
 
 type MaddenItem struct {
@@ -24,10 +37,22 @@ type MaddenItem struct {
 	Summary string
 	//additional details about the madden item
 	Details string
-	//historical flag, currently no use
+	//historical items are listed apart from current ones, items are marked historical once they have ended
 	IsHistorical bool
+	//incremented on every update, updates must name the version they replace
+	Version uint `gorm:"not null;default:1"`
+	//RFC 5545 recurrence rule repeating the item, empty if the item happens once, see ParseRRule
+	RRule string `gorm:"column:rrule;not null;default:''"`
+	//end of the last occurrence of a recurring item, 0 if the item does not recur or recurs forever
+	SeriesEnd int64 `gorm:"not null;default:0"`
 	//Join table reference
 	ItemImages []ItemImages
+	//edited and cancelled occurrences of a recurring item
+	Occurrences []ItemOccurrence
+	//start of the occurrence a listed item was expanded from, 0 for the item itself
+	OccurrenceStart int64 `gorm:"-"`
+	//conflicts the write returning the item found with other items, the item is the first window of each
+	Conflicts []ItemConflict `gorm:"-"`
 }
 
 //an image entity to be associated with a madden item
@@ -43,6 +68,100 @@ type ItemImages struct {
 	MaddenImageFileId uint
 }
 
+//an edited or cancelled occurrence of a recurring madden item, occurrences without one follow the item
+type ItemOccurrence struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	//id of the recurring madden item
+	MaddenItemId uint `gorm:"not null"`
+	//start of the occurrence as the rule places it, identifies the occurrence however it is edited
+	OccurrenceStart int64 `gorm:"not null"`
+	//cancelled occurrences are left out of listings, the remaining fields are unused
+	Cancelled bool `gorm:"not null;default:false"`
+	//replace the fields of the item for this occurrence
+	BeginDate int64  `gorm:"not null;default:0"`
+	EndDate   int64  `gorm:"not null;default:0"`
+	Summary   string `gorm:"not null;default:''"`
+	Details   string `gorm:"not null;default:''"`
+}
+
+//an immutable record of a single create, update or delete of a madden item
+type ItemRevision struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	//id of the revised madden item
+	MaddenItemId uint `gorm:"not null"`
+	//per item revision number starting at 1
+	Revision uint `gorm:"not null"`
+	//one of create update delete restore
+	Action string `gorm:"not null"`
+	//who made the change
+	Actor string `gorm:"not null"`
+	//json encoded ItemSnapshot of the item after the change
+	Snapshot string `gorm:"not null"`
+	//json encoded FieldChange slice describing the difference from the previous revision
+	Changes string `gorm:"not null"`
+}
+
+//a subscription to change events, each event is posted to Url signed with Secret
+type WebhookSubscription struct {
+	gorm.Model
+	//absolute url events are posted to
+	Url string `gorm:"not null"`
+	//key of the HMAC-SHA256 signature sent with every delivery
+	Secret string `gorm:"not null"`
+	//comma separated event types delivered, every event type if empty
+	Events string `gorm:"not null;default:''"`
+}
+
+//a single event queued for delivery to a single subscription, the outbox row is written in the same transaction as the change
+type WebhookDelivery struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	//the subscription the event is delivered to
+	WebhookSubscription   WebhookSubscription
+	WebhookSubscriptionId uint `gorm:"not null"`
+	//one of the EVENT_ constants
+	Event string `gorm:"not null"`
+	//json encoded WebhookPayload posted to the subscription
+	Payload string `gorm:"not null"`
+	//one of pending delivered dead
+	Status string `gorm:"not null"`
+	//attempts made so far
+	Attempts uint `gorm:"not null;default:0"`
+	//earliest time of the next attempt while pending
+	NextAttemptAt time.Time `gorm:"not null"`
+	//error of the most recent failed attempt
+	LastError string `gorm:"not null;default:''"`
+	//nil until delivered
+	DeliveredAt *time.Time
+}
+
+//a committed change in the log streamed to live clients, ids increase in commit order
+type ChangeEvent struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	//one of the EVENT_ constants
+	Event string `gorm:"not null"`
+	//json encoded WebhookPayload announcing the change
+	Payload string `gorm:"not null"`
+}
+
+//the latest run of a background job, shared by every instance of the service
+type JobRun struct {
+	//one of the JOB_ constants
+	Name       string `gorm:"primarykey"`
+	StartedAt  time.Time
+	FinishedAt time.Time
+	//the job specific threshold of the run, items ending before it are historicized by JOB_HISTORICIZE
+	Cutoff time.Time
+	//rows changed by the latest run and by every run
+	Changed      int64 `gorm:"not null;default:0"`
+	TotalChanged int64 `gorm:"not null;default:0"`
+}
+
 type Summary struct {
 	gorm.Model
 	Summary string `gorm:"not null"`
@@ -0,0 +1,580 @@
+package maddendb
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//defines recurring madden items, a subset of RFC 5545 recurrence rules evaluated in UTC
+//the first occurrence of a series is the item itself, later occurrences repeat its time of day and duration
+
+const (
+	FREQUENCY_DAILY   = "DAILY"
+	FREQUENCY_WEEKLY  = "WEEKLY"
+	FREQUENCY_MONTHLY = "MONTHLY"
+	FREQUENCY_YEARLY  = "YEARLY"
+
+	//optional prefix of a rule copied from an iCalendar file
+	RRULE_PREFIX = "RRULE:"
+	//rule periods stepped through before giving up on finding further occurrences, guards rules that match rarely or never
+	MAX_RECURRENCE_PERIODS = 100000
+)
+
+//weekday abbreviations used by BYDAY and WKST
+var ruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+//Recurrence is a parsed recurrence rule
+type Recurrence struct {
+	//one of the FREQUENCY_ constants
+	Frequency string
+	//periods between occurrences, 1 repeats every period
+	Interval int
+	//occurrences in the series counting the first, 0 if not limited by count
+	Count int
+	//latest start of an occurrence as a unix time, 0 if not limited by date
+	Until int64
+	//parts selecting the days occurrences fall on, every part given must match
+	ByDay      []RuleWeekday
+	ByMonthDay []int
+	ByMonth    []time.Month
+	//first day of the week counted by weekly rules with an interval
+	WeekStart time.Weekday
+}
+
+//RuleWeekday is a single BYDAY value, an ordinal selects a single weekday within a month counting from the end if negative
+type RuleWeekday struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+//ParseRRule parses a recurrence rule such as FREQ=WEEKLY;BYDAY=TU or FREQ=MONTHLY;BYDAY=1TU
+//FREQ may be DAILY WEEKLY MONTHLY or YEARLY, and INTERVAL COUNT UNTIL BYDAY BYMONTHDAY BYMONTH and WKST are supported
+//any other part is rejected rather than ignored so a rule never quietly means something else
+func ParseRRule(rule string) (Recurrence, error) {
+	recurrence := Recurrence{Interval: 1, WeekStart: time.Monday}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(NormalizeRRule(rule), ";") {
+		name := strings.SplitN(part, "=", 2)
+		if len(name) != 2 || name[1] == "" {
+			return Recurrence{}, ruleError(rule, fmt.Sprintf("%q is not a NAME=VALUE part", part))
+		}
+		if seen[name[0]] {
+			return Recurrence{}, ruleError(rule, fmt.Sprintf("%s is given more than once", name[0]))
+		}
+		seen[name[0]] = true
+		var err error
+		switch name[0] {
+		case "FREQ":
+			recurrence.Frequency = name[1]
+			if recurrence.Frequency != FREQUENCY_DAILY && recurrence.Frequency != FREQUENCY_WEEKLY && recurrence.Frequency != FREQUENCY_MONTHLY && recurrence.Frequency != FREQUENCY_YEARLY {
+				err = fmt.Errorf("FREQ must be one of DAILY WEEKLY MONTHLY YEARLY")
+			}
+		case "INTERVAL":
+			recurrence.Interval, err = parseRulePositive(name[0], name[1])
+		case "COUNT":
+			recurrence.Count, err = parseRulePositive(name[0], name[1])
+		case "UNTIL":
+			recurrence.Until, err = parseRuleUntil(name[1])
+		case "BYDAY":
+			recurrence.ByDay, err = parseRuleWeekdays(name[1])
+		case "BYMONTHDAY":
+			recurrence.ByMonthDay, err = parseRuleMonthDays(name[1])
+		case "BYMONTH":
+			recurrence.ByMonth, err = parseRuleMonths(name[1])
+		case "WKST":
+			weekday, known := ruleWeekdays[name[1]]
+			if !known {
+				err = fmt.Errorf("WKST must be a weekday such as MO")
+			}
+			recurrence.WeekStart = weekday
+		default:
+			err = fmt.Errorf("%s is not supported", name[0])
+		}
+		if err != nil {
+			return Recurrence{}, ruleError(rule, err.Error())
+		}
+	}
+	if err := recurrence.validate(); err != nil {
+		return Recurrence{}, ruleError(rule, err.Error())
+	}
+	return recurrence, nil
+}
+
+//NormalizeRRule returns rule in the form it is stored, upper case without any RRULE: prefix
+func NormalizeRRule(rule string) string {
+	return strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), RRULE_PREFIX)
+}
+
+//Bounded returns true if the series ends, either after a count of occurrences or at a date
+func (recurrence Recurrence) Bounded() bool {
+	return recurrence.Count > 0 || recurrence.Until != 0
+}
+
+//Each calls visit with the start of each occurrence of a series first starting at start, in order, until visit returns false or the series ends
+//start is always the first occurrence even if the rule would not select it, true is returned if every occurrence was visited
+func (recurrence Recurrence) Each(start int64, visit func(occurrenceStart int64) bool) bool {
+	first := time.Unix(start, 0).UTC()
+	emitted := 0
+	ended := false
+	emit := func(occurrence time.Time) bool {
+		if recurrence.Until != 0 && occurrence.Unix() > recurrence.Until {
+			ended = true
+			return false
+		}
+		emitted++
+		if !visit(occurrence.Unix()) {
+			return false
+		}
+		ended = recurrence.Count != 0 && emitted >= recurrence.Count
+		return !ended
+	}
+	if !emit(first) {
+		return ended
+	}
+	for period := 0; period < MAX_RECURRENCE_PERIODS; period++ {
+		candidates := recurrence.candidates(first, period)
+		for _, candidate := range candidates {
+			if !candidate.After(first) {
+				continue
+			}
+			if !emit(candidate) {
+				return ended
+			}
+		}
+		//a candidate past the end of the series ends it, later periods only hold later candidates
+		if len(candidates) > 0 && recurrence.Until != 0 && candidates[len(candidates)-1].Unix() > recurrence.Until {
+			return true
+		}
+	}
+	return false
+}
+
+//Includes returns true if a series first starting at start has an occurrence starting at occurrenceStart
+func (recurrence Recurrence) Includes(start, occurrenceStart int64) bool {
+	found := false
+	recurrence.Each(start, func(candidate int64) bool {
+		found = candidate == occurrenceStart
+		return candidate < occurrenceStart
+	})
+	return found
+}
+
+//validate rejects combinations of parts this subset of RFC 5545 does not define
+func (recurrence Recurrence) validate() error {
+	if recurrence.Frequency == "" {
+		return fmt.Errorf("FREQ is required")
+	}
+	if recurrence.Count > 0 && recurrence.Until != 0 {
+		return fmt.Errorf("COUNT and UNTIL can not be combined")
+	}
+	for _, day := range recurrence.ByDay {
+		if day.Ordinal == 0 {
+			continue
+		}
+		if recurrence.Frequency != FREQUENCY_MONTHLY && recurrence.Frequency != FREQUENCY_YEARLY {
+			return fmt.Errorf("BYDAY ordinals such as 1TU are only supported by MONTHLY and YEARLY rules")
+		}
+	}
+	if recurrence.Frequency == FREQUENCY_YEARLY && len(recurrence.ByDay) > 0 && len(recurrence.ByMonth) == 0 {
+		return fmt.Errorf("BYDAY needs BYMONTH in YEARLY rules")
+	}
+	if recurrence.Frequency == FREQUENCY_WEEKLY && len(recurrence.ByMonthDay) > 0 {
+		return fmt.Errorf("BYMONTHDAY can not be combined with FREQ=WEEKLY")
+	}
+	return nil
+}
+
+//candidates returns the occurrences the rule selects within a single period of the series first starting at first, in order
+//periods are counted from the one holding first, every INTERVAL periods are selected
+func (recurrence Recurrence) candidates(first time.Time, period int) []time.Time {
+	step := period * recurrence.Interval
+	days := []time.Time{}
+	switch recurrence.Frequency {
+	case FREQUENCY_DAILY:
+		day := ruleDate(first.Year(), first.Month(), first.Day()+step)
+		if recurrence.monthSelected(day.Month()) && recurrence.monthDaySelected(day) && recurrence.weekdaySelected(day.Weekday()) {
+			days = append(days, day)
+		}
+	case FREQUENCY_WEEKLY:
+		offset := (int(first.Weekday()) - int(recurrence.WeekStart) + 7) % 7
+		weekStart := ruleDate(first.Year(), first.Month(), first.Day()-offset+7*step)
+		for i := 0; i < 7; i++ {
+			day := weekStart.AddDate(0, 0, i)
+			selected := day.Weekday() == first.Weekday()
+			if len(recurrence.ByDay) > 0 {
+				selected = recurrence.weekdaySelected(day.Weekday())
+			}
+			if selected && recurrence.monthSelected(day.Month()) {
+				days = append(days, day)
+			}
+		}
+	case FREQUENCY_MONTHLY:
+		month := ruleDate(first.Year(), first.Month()+time.Month(step), 1)
+		if recurrence.monthSelected(month.Month()) {
+			days = recurrence.monthDays(month.Year(), month.Month(), first.Day())
+		}
+	case FREQUENCY_YEARLY:
+		months := recurrence.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{first.Month()}
+			//a yearly rule selecting days of the month without naming months selects them in every month
+			if len(recurrence.ByMonthDay) > 0 {
+				months = []time.Month{}
+				for month := time.January; month <= time.December; month++ {
+					months = append(months, month)
+				}
+			}
+		}
+		for _, month := range months {
+			days = append(days, recurrence.monthDays(first.Year()+step, month, first.Day())...)
+		}
+	}
+	candidates := []time.Time{}
+	for _, day := range days {
+		candidates = append(candidates, time.Date(day.Year(), day.Month(), day.Day(), first.Hour(), first.Minute(), first.Second(), 0, time.UTC))
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+	return candidates
+}
+
+//monthDays returns the days of month selected by BYMONTHDAY and BYDAY, or day alone if neither is given, when both are given a day must match each
+func (recurrence Recurrence) monthDays(year int, month time.Month, day int) []time.Time {
+	last := ruleDate(year, month+1, 0).Day()
+	days := []time.Time{}
+	for candidate := 1; candidate <= last; candidate++ {
+		date := ruleDate(year, month, candidate)
+		selected := candidate == day
+		if len(recurrence.ByMonthDay) > 0 || len(recurrence.ByDay) > 0 {
+			selected = recurrence.monthDaySelected(date) && recurrence.monthWeekdaySelected(date, last)
+		}
+		if selected {
+			days = append(days, date)
+		}
+	}
+	return days
+}
+
+func (recurrence Recurrence) monthSelected(month time.Month) bool {
+	if len(recurrence.ByMonth) == 0 {
+		return true
+	}
+	for _, selected := range recurrence.ByMonth {
+		if selected == month {
+			return true
+		}
+	}
+	return false
+}
+
+//monthDaySelected returns true if date is one of the BYMONTHDAY days, negative days count back from the end of the month
+func (recurrence Recurrence) monthDaySelected(date time.Time) bool {
+	if len(recurrence.ByMonthDay) == 0 {
+		return true
+	}
+	last := ruleDate(date.Year(), date.Month()+1, 0).Day()
+	for _, day := range recurrence.ByMonthDay {
+		if day == date.Day() || (day < 0 && last+1+day == date.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (recurrence Recurrence) weekdaySelected(weekday time.Weekday) bool {
+	if len(recurrence.ByDay) == 0 {
+		return true
+	}
+	for _, day := range recurrence.ByDay {
+		if day.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+//monthWeekdaySelected returns true if date matches a BYDAY value within its month of last days, 1TU is the first tuesday and -1FR the last friday
+func (recurrence Recurrence) monthWeekdaySelected(date time.Time, last int) bool {
+	if len(recurrence.ByDay) == 0 {
+		return true
+	}
+	for _, day := range recurrence.ByDay {
+		if day.Weekday != date.Weekday() {
+			continue
+		}
+		if day.Ordinal == 0 || day.Ordinal == (date.Day()-1)/7+1 || day.Ordinal == -((last-date.Day())/7+1) {
+			return true
+		}
+	}
+	return false
+}
+
+//ruleDate returns midnight UTC of a date, normalizing days and months outside their usual range
+func ruleDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func parseRulePositive(name, value string) (int, error) {
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 1 {
+		return 0, fmt.Errorf("%s must be a positive integer", name)
+	}
+	return parsed, nil
+}
+
+//parseRuleUntil parses a UTC date time such as 20261231T235959Z, a date alone lasts until the end of the day
+func parseRuleUntil(value string) (int64, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until.Unix(), nil
+	}
+	if until, err := time.Parse("20060102", value); err == nil {
+		return until.AddDate(0, 0, 1).Unix() - 1, nil
+	}
+	return 0, fmt.Errorf("UNTIL must be a UTC date time such as 20261231T235959Z or a date such as 20261231")
+}
+
+func parseRuleWeekdays(value string) ([]RuleWeekday, error) {
+	weekdays := []RuleWeekday{}
+	for _, day := range strings.Split(value, ",") {
+		if len(day) < 2 {
+			return nil, fmt.Errorf("BYDAY values must be weekdays such as TU or 1TU")
+		}
+		weekday, known := ruleWeekdays[day[len(day)-2:]]
+		if !known {
+			return nil, fmt.Errorf("BYDAY values must be weekdays such as TU or 1TU")
+		}
+		ordinal := 0
+		if prefix := day[:len(day)-2]; prefix != "" {
+			parsed, err := strconv.Atoi(prefix)
+			if err != nil || parsed == 0 || parsed < -5 || parsed > 5 {
+				return nil, fmt.Errorf("BYDAY ordinals must be between -5 and 5 excluding 0")
+			}
+			ordinal = parsed
+		}
+		weekdays = append(weekdays, RuleWeekday{Ordinal: ordinal, Weekday: weekday})
+	}
+	return weekdays, nil
+}
+
+func parseRuleMonthDays(value string) ([]int, error) {
+	days := []int{}
+	for _, day := range strings.Split(value, ",") {
+		parsed, err := strconv.Atoi(day)
+		if err != nil || parsed == 0 || parsed < -31 || parsed > 31 {
+			return nil, fmt.Errorf("BYMONTHDAY values must be between -31 and 31 excluding 0")
+		}
+		days = append(days, parsed)
+	}
+	return days, nil
+}
+
+func parseRuleMonths(value string) ([]time.Month, error) {
+	months := []time.Month{}
+	for _, month := range strings.Split(value, ",") {
+		parsed, err := strconv.Atoi(month)
+		if err != nil || parsed < 1 || parsed > 12 {
+			return nil, fmt.Errorf("BYMONTH values must be between 1 and 12")
+		}
+		months = append(months, time.Month(parsed))
+	}
+	return months, nil
+}
+
+func ruleError(rule, reason string) error {
+	return &DbError{Message: fmt.Sprintf("recurrence rule %q is invalid, %s", rule, reason)}
+}
+
+//occurrenceItem returns the occurrence of series starting at occurrenceStart, replacing its fields with those of exception unless it is nil
+func occurrenceItem(series MaddenItem, occurrenceStart int64, exception *ItemOccurrence) MaddenItem {
+	occurrence := series
+	occurrence.Occurrences = nil
+	occurrence.OccurrenceStart = occurrenceStart
+	occurrence.BeginDate = occurrenceStart
+	occurrence.EndDate = occurrenceStart + series.EndDate - series.BeginDate
+	if exception != nil {
+		occurrence.BeginDate = exception.BeginDate
+		occurrence.EndDate = exception.EndDate
+		occurrence.Summary = exception.Summary
+		occurrence.Details = exception.Details
+	}
+	return occurrence
+}
+
+//expandSeries returns the occurrences of series starting before startDate and ending after endDate that keep returns true for
+//edited occurrences are always returned, at most limit others are, those that come first in either sort order
+//occurrences follow the rule in start order and share a duration so the first limit in start order are also the first limit in end order
+func expandSeries(series MaddenItem, startDate, endDate int64, keep func(occurrence MaddenItem) bool, limit int) ([]MaddenItem, error) {
+	recurrence, err := ParseRRule(series.RRule)
+	if err != nil {
+		return nil, err
+	}
+	occurrences := []MaddenItem{}
+	exceptions := map[int64]bool{}
+	for i := range series.Occurrences {
+		exception := series.Occurrences[i]
+		exceptions[exception.OccurrenceStart] = true
+		if exception.Cancelled {
+			continue
+		}
+		occurrence := occurrenceItem(series, exception.OccurrenceStart, &exception)
+		if occurrence.BeginDate < startDate && occurrence.EndDate > endDate && keep(occurrence) {
+			occurrences = append(occurrences, occurrence)
+		}
+	}
+	if limit <= 0 {
+		return occurrences, nil
+	}
+	generated := 0
+	recurrence.Each(series.BeginDate, func(occurrenceStart int64) bool {
+		//no later occurrence can start before startDate either
+		if occurrenceStart >= startDate {
+			return false
+		}
+		if exceptions[occurrenceStart] {
+			return true
+		}
+		occurrence := occurrenceItem(series, occurrenceStart, nil)
+		if occurrence.EndDate > endDate && keep(occurrence) {
+			occurrences = append(occurrences, occurrence)
+			generated++
+		}
+		return generated < limit
+	})
+	return occurrences, nil
+}
+
+//expandAllSeries expands each of series in turn, see expandSeries
+func expandAllSeries(series []MaddenItem, startDate, endDate int64, keep func(occurrence MaddenItem) bool, limit int) ([]MaddenItem, error) {
+	occurrences := []MaddenItem{}
+	for _, recurring := range series {
+		expanded, err := expandSeries(recurring, startDate, endDate, keep, limit)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, expanded...)
+	}
+	return occurrences, nil
+}
+
+//mergeItems returns items and occurrences sorted together by sortField
+func mergeItems(items, occurrences []MaddenItem, sortField SortField) []MaddenItem {
+	merged := append(append([]MaddenItem{}, items...), occurrences...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return itemLess(&merged[i], &merged[j], sortField)
+	})
+	return merged
+}
+
+//seriesEnd returns the end of the last occurrence of item, 0 if it does not recur or its occurrences never end
+func seriesEnd(item MaddenItem) int64 {
+	if item.RRule == "" {
+		return 0
+	}
+	recurrence, err := ParseRRule(item.RRule)
+	if err != nil || !recurrence.Bounded() {
+		return 0
+	}
+	last := item.EndDate
+	duration := item.EndDate - item.BeginDate
+	//a series with more occurrences than can be stepped through is treated as never ending
+	if !recurrence.Each(item.BeginDate, func(occurrenceStart int64) bool {
+		last = occurrenceStart + duration
+		return true
+	}) {
+		return 0
+	}
+	for _, exception := range item.Occurrences {
+		if !exception.Cancelled && exception.EndDate > last {
+			last = exception.EndDate
+		}
+	}
+	return last
+}
+
+//keptOccurrences returns the exceptions that are still occurrences of item, exceptions of occurrences the rule or start of item no longer has are dropped
+func keptOccurrences(item MaddenItem, exceptions []ItemOccurrence) []ItemOccurrence {
+	kept := []ItemOccurrence{}
+	if item.RRule == "" {
+		return kept
+	}
+	recurrence, err := ParseRRule(item.RRule)
+	if err != nil {
+		return kept
+	}
+	for _, exception := range exceptions {
+		if recurrence.Includes(item.BeginDate, exception.OccurrenceStart) {
+			kept = append(kept, exception)
+		}
+	}
+	return kept
+}
+
+//checkOccurrence returns an error unless item recurs with an occurrence starting at occurrenceStart
+func checkOccurrence(item MaddenItem, occurrenceStart int64) error {
+	if item.RRule == "" {
+		return &DbError{Message: fmt.Sprintf("item with ID: %d does not recur", item.ID), OriginalError: gorm.ErrRecordNotFound}
+	}
+	recurrence, err := ParseRRule(item.RRule)
+	if err != nil {
+		return err
+	}
+	if !recurrence.Includes(item.BeginDate, occurrenceStart) {
+		return &DbError{Message: fmt.Sprintf("item with ID: %d has no occurrence starting at %s", item.ID, time.Unix(occurrenceStart, 0).UTC().Format(time.RFC3339)), OriginalError: gorm.ErrRecordNotFound}
+	}
+	return nil
+}
+
+//setOccurrence returns exceptions with occurrence replacing any exception of the same occurrence
+func setOccurrence(exceptions []ItemOccurrence, occurrence ItemOccurrence) []ItemOccurrence {
+	set := []ItemOccurrence{}
+	for _, exception := range exceptions {
+		if exception.OccurrenceStart != occurrence.OccurrenceStart {
+			set = append(set, exception)
+		}
+	}
+	set = append(set, occurrence)
+	sort.Slice(set, func(i, j int) bool { return set[i].OccurrenceStart < set[j].OccurrenceStart })
+	return set
+}
+
+//droppedOccurrenceIds returns the ids of the stored exceptions missing from kept
+func droppedOccurrenceIds(stored, kept []ItemOccurrence) []uint {
+	remaining := map[uint]bool{}
+	for _, exception := range kept {
+		remaining[exception.ID] = true
+	}
+	dropped := []uint{}
+	for _, exception := range stored {
+		if !remaining[exception.ID] {
+			dropped = append(dropped, exception.ID)
+		}
+	}
+	return dropped
+}
+
+//validateRecurrence returns an error if item recurs by a rule that is invalid or ends before the item starts
+func validateRecurrence(item MaddenItem) error {
+	if item.RRule == "" {
+		return nil
+	}
+	recurrence, err := ParseRRule(item.RRule)
+	if err != nil {
+		return err
+	}
+	if recurrence.Until != 0 && recurrence.Until < item.BeginDate {
+		return ruleError(item.RRule, "UNTIL is before the start of the entry")
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package maddendb
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+//defines madden item revision snapshots and the diffs between them
+
+const (
+	REVISION_CREATE  = "create"
+	REVISION_UPDATE  = "update"
+	REVISION_DELETE  = "delete"
+	REVISION_RESTORE = "restore"
+
+	//revision field names, these match the field names used by api clients
+	FIELD_START_DATE = "startDate"
+	FIELD_END_DATE   = "endDate"
+	FIELD_SUMMARY    = "summary"
+	FIELD_DETAILS    = "details"
+	FIELD_HISTORICAL = "historical"
+	FIELD_IMAGES     = "images"
+	FIELD_DELETED    = "deleted"
+	FIELD_RRULE      = "rrule"
+	FIELD_EXCEPTIONS = "exceptions"
+)
+
+//ItemSnapshot is the full state of a madden item and its image associations at a single revision
+type ItemSnapshot struct {
+	BeginDate    int64           `json:"beginDate"`
+	EndDate      int64           `json:"endDate"`
+	Summary      string          `json:"summary"`
+	Details      string          `json:"details"`
+	IsHistorical bool            `json:"isHistorical"`
+	Deleted      bool            `json:"deleted"`
+	Images       []ImageSnapshot `json:"images"`
+	//only recorded for recurring items
+	RRule      string               `json:"rrule,omitempty"`
+	Exceptions []OccurrenceSnapshot `json:"exceptions,omitempty"`
+}
+
+//ImageSnapshot is an image association of a madden item at a single revision
+type ImageSnapshot struct {
+	MaddenImageFileId uint   `json:"imageId"`
+	Status            string `json:"status"`
+	//file names are recorded so history still renders if the image is later changed or removed
+	FileName  string `json:"fileName"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+//OccurrenceSnapshot is an edited or cancelled occurrence of a recurring madden item at a single revision
+type OccurrenceSnapshot struct {
+	OccurrenceStart int64  `json:"occurrenceStart"`
+	Cancelled       bool   `json:"cancelled"`
+	BeginDate       int64  `json:"beginDate,omitempty"`
+	EndDate         int64  `json:"endDate,omitempty"`
+	Summary         string `json:"summary,omitempty"`
+	Details         string `json:"details,omitempty"`
+}
+
+//FieldChange is a single changed field between two revisions
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+//GetSnapshot decodes the item state recorded by this revision
+func (revision ItemRevision) GetSnapshot() (ItemSnapshot, error) {
+	snapshot := ItemSnapshot{}
+	if err := json.Unmarshal([]byte(revision.Snapshot), &snapshot); err != nil {
+		return snapshot, &DbError{Message: fmt.Sprintf("revision %d of item %d is corrupt", revision.Revision, revision.MaddenItemId), OriginalError: err}
+	}
+	return snapshot, nil
+}
+
+//GetChanges decodes the changes from the previous revision recorded by this revision
+func (revision ItemRevision) GetChanges() ([]FieldChange, error) {
+	changes := []FieldChange{}
+	if err := json.Unmarshal([]byte(revision.Changes), &changes); err != nil {
+		return changes, &DbError{Message: fmt.Sprintf("revision %d of item %d is corrupt", revision.Revision, revision.MaddenItemId), OriginalError: err}
+	}
+	return changes, nil
+}
+
+//SnapshotItem captures the state of item, images are ordered by image id and exceptions by occurrence so snapshots compare consistently
+func SnapshotItem(item MaddenItem) ItemSnapshot {
+	snapshot := ItemSnapshot{
+		BeginDate:    item.BeginDate,
+		EndDate:      item.EndDate,
+		Summary:      item.Summary,
+		Details:      item.Details,
+		IsHistorical: item.IsHistorical,
+		Deleted:      item.DeletedAt.Valid,
+		Images:       []ImageSnapshot{},
+		RRule:        item.RRule,
+	}
+	for _, exception := range item.Occurrences {
+		snapshot.Exceptions = append(snapshot.Exceptions, OccurrenceSnapshot{
+			OccurrenceStart: exception.OccurrenceStart,
+			Cancelled:       exception.Cancelled,
+			BeginDate:       exception.BeginDate,
+			EndDate:         exception.EndDate,
+			Summary:         exception.Summary,
+			Details:         exception.Details,
+		})
+	}
+	sort.Slice(snapshot.Exceptions, func(i, j int) bool {
+		return snapshot.Exceptions[i].OccurrenceStart < snapshot.Exceptions[j].OccurrenceStart
+	})
+	for _, itemImage := range item.ItemImages {
+		snapshot.Images = append(snapshot.Images, ImageSnapshot{
+			MaddenImageFileId: itemImageFileId(itemImage),
+			Status:            itemImage.Status,
+			FileName:          itemImage.MaddenImageFile.FileName,
+			Thumbnail:         itemImage.MaddenImageFile.Thumbnail,
+		})
+	}
+	sort.Slice(snapshot.Images, func(i, j int) bool {
+		return snapshot.Images[i].MaddenImageFileId < snapshot.Images[j].MaddenImageFileId
+	})
+	return snapshot
+}
+
+//DiffSnapshots returns every field that differs between from and to, images are compared on id and status only
+func DiffSnapshots(from, to ItemSnapshot) []FieldChange {
+	changes := []FieldChange{}
+	addIfChanged := func(field string, fromValue, toValue interface{}) {
+		if fromValue != toValue {
+			changes = append(changes, FieldChange{Field: field, From: fromValue, To: toValue})
+		}
+	}
+	addIfChanged(FIELD_START_DATE, from.BeginDate, to.BeginDate)
+	addIfChanged(FIELD_END_DATE, from.EndDate, to.EndDate)
+	addIfChanged(FIELD_SUMMARY, from.Summary, to.Summary)
+	addIfChanged(FIELD_DETAILS, from.Details, to.Details)
+	addIfChanged(FIELD_HISTORICAL, from.IsHistorical, to.IsHistorical)
+	if !imagesEqual(from.Images, to.Images) {
+		changes = append(changes, FieldChange{Field: FIELD_IMAGES, From: from.Images, To: to.Images})
+	}
+	addIfChanged(FIELD_RRULE, from.RRule, to.RRule)
+	if !occurrencesEqual(from.Exceptions, to.Exceptions) {
+		changes = append(changes, FieldChange{Field: FIELD_EXCEPTIONS, From: from.Exceptions, To: to.Exceptions})
+	}
+	addIfChanged(FIELD_DELETED, from.Deleted, to.Deleted)
+	return changes
+}
+
+//buildRevision builds the revision following previous, previous is nil for the first revision of an item
+func buildRevision(itemId uint, action, actor string, previous *ItemRevision, current ItemSnapshot) (ItemRevision, error) {
+	revision := ItemRevision{MaddenItemId: itemId, Revision: 1, Action: action, Actor: actor}
+	base := ItemSnapshot{Images: []ImageSnapshot{}}
+	if previous != nil {
+		revision.Revision = previous.Revision + 1
+		snapshot, err := previous.GetSnapshot()
+		if err != nil {
+			return revision, err
+		}
+		base = snapshot
+	}
+	snapshotJson, err := json.Marshal(current)
+	if err != nil {
+		return revision, &DbError{Message: "error encoding revision snapshot", OriginalError: err}
+	}
+	changesJson, err := json.Marshal(DiffSnapshots(base, current))
+	if err != nil {
+		return revision, &DbError{Message: "error encoding revision changes", OriginalError: err}
+	}
+	revision.Snapshot = string(snapshotJson)
+	revision.Changes = string(changesJson)
+	return revision, nil
+}
+
+//imagesEqual compares image associations on id and status, both slices must be ordered by image id
+func imagesEqual(a, b []ImageSnapshot) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].MaddenImageFileId != b[i].MaddenImageFileId || a[i].Status != b[i].Status {
+			return false
+		}
+	}
+	return true
+}
+
+//occurrencesEqual compares exceptions field by field, both slices must be ordered by occurrence
+func occurrencesEqual(a, b []OccurrenceSnapshot) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,204 @@
+package maddendb
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//defines full text search queries over madden item summaries and details
+
+const (
+	//text search configuration used by the search_vector column, queries must use the same configuration
+	searchConfig = "english"
+	//marks placed around matched words in search snippets
+	HIGHLIGHT_START = "<mark>"
+	HIGHLIGHT_END   = "</mark>"
+	//ranking weights of summary and details matches, mirroring the default A and B weights of postgres
+	summaryWeight = 1.0
+	detailsWeight = 0.4
+)
+
+var (
+	//a quoted phrase or a single word, words may end with * for prefix matching
+	searchToken = regexp.MustCompile(`"([^"]*)"|(\S+)`)
+	//characters that make up a searchable word, everything else separates words
+	searchWord = regexp.MustCompile(`[\p{L}\p{N}]+`)
+	//replacements made by html.EscapeString in the order postgres must apply them, ampersands first so later escapes are left alone
+	htmlEscapes = [][2]string{{"&", "&amp;"}, {"'", "&#39;"}, {"<", "&lt;"}, {">", "&gt;"}, {`"`, "&#34;"}}
+)
+
+//SearchTerm is a single word, prefix or phrase that every search result must contain
+type SearchTerm struct {
+	//more than one word is a phrase that must appear in order
+	Words []string
+	//true if the last word matches any word it is a prefix of
+	Prefix bool
+}
+
+//SearchResult is a madden item matching a search with its rank and highlighted snippets
+type SearchResult struct {
+	Item MaddenItem
+	//higher ranks are better matches
+	Rank float64
+	//summary and details with matched words wrapped in HIGHLIGHT_START and HIGHLIGHT_END, the text is html escaped so only the marks are markup
+	SummarySnippet string
+	DetailsSnippet string
+}
+
+//ParseSearchQuery splits query into terms, quoted text is a phrase and a trailing * is a prefix match
+//every term must match, an error is returned if the query holds no searchable words
+func ParseSearchQuery(query string) ([]SearchTerm, error) {
+	terms := []SearchTerm{}
+	for _, token := range searchToken.FindAllStringSubmatch(query, -1) {
+		text, prefix := token[2], false
+		if token[1] != "" || token[2] == "" {
+			text = token[1]
+		} else if strings.HasSuffix(text, "*") {
+			prefix = true
+		}
+		words := searchWord.FindAllString(strings.ToLower(text), -1)
+		if len(words) == 0 {
+			continue
+		}
+		terms = append(terms, SearchTerm{Words: words, Prefix: prefix})
+	}
+	if len(terms) == 0 {
+		return nil, &DbError{Message: "search query held no searchable words", OriginalError: fmt.Errorf("query %q", query)}
+	}
+	return terms, nil
+}
+
+//tsQuery converts terms to postgres to_tsquery syntax, words are already stripped of operators by ParseSearchQuery
+func tsQuery(terms []SearchTerm) string {
+	parts := []string{}
+	for _, term := range terms {
+		part := strings.Join(term.Words, " <-> ")
+		if term.Prefix {
+			part += ":*"
+		}
+		if len(term.Words) > 1 {
+			part = "(" + part + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " & ")
+}
+
+//searchVectorSql returns a postgres expression building the search vector of the summary and details columns, the same way the search_vector column is generated
+func searchVectorSql(summary, details string) string {
+	return fmt.Sprintf("(setweight(to_tsvector('%[1]s', coalesce(%[2]s, '')), 'A') || setweight(to_tsvector('%[1]s', coalesce(%[3]s, '')), 'B'))", searchConfig, summary, details)
+}
+
+//searchSelection returns the rank and snippets selected for a match of the vector against query, the query joined by the search
+//the text is escaped before ts_headline adds the marks, the parser reads the escapes as entities so matching is unchanged
+func searchSelection(vector, summary, details string) string {
+	return fmt.Sprintf("ts_rank_cd(%[6]s, query) AS rank, "+
+		"ts_headline('%[1]s', %[4]s, query, 'StartSel=%[2]s, StopSel=%[3]s, HighlightAll=true') AS summary_snippet, "+
+		"ts_headline('%[1]s', %[5]s, query, 'StartSel=%[2]s, StopSel=%[3]s, MaxFragments=2') AS details_snippet", searchConfig, HIGHLIGHT_START, HIGHLIGHT_END, htmlEscapeSql(summary), htmlEscapeSql(details), vector)
+}
+
+//htmlEscapeSql returns a postgres expression html escaping column the same way as html.EscapeString
+func htmlEscapeSql(column string) string {
+	expression := column
+	for _, escape := range htmlEscapes {
+		expression = fmt.Sprintf("replace(%s, '%s', '%s')", expression, strings.ReplaceAll(escape[0], "'", "''"), escape[1])
+	}
+	return expression
+}
+
+//searchText is text split into words for in memory matching
+type searchText struct {
+	text  string
+	words []string
+	//byte offsets of each word in text
+	bounds [][]int
+}
+
+func newSearchText(text string) searchText {
+	bounds := searchWord.FindAllStringIndex(text, -1)
+	words := make([]string, len(bounds))
+	for i, bound := range bounds {
+		words[i] = strings.ToLower(text[bound[0]:bound[1]])
+	}
+	return searchText{text: text, words: words, bounds: bounds}
+}
+
+//matches returns the index of every word that is part of a match of term
+func (st searchText) matches(term SearchTerm) []int {
+	matched := []int{}
+	for start := 0; start+len(term.Words) <= len(st.words); start++ {
+		found := true
+		for i, word := range term.Words {
+			candidate := st.words[start+i]
+			last := i == len(term.Words)-1
+			if candidate != word && !(last && term.Prefix && strings.HasPrefix(candidate, word)) {
+				found = false
+				break
+			}
+		}
+		if found {
+			for i := range term.Words {
+				matched = append(matched, start+i)
+			}
+		}
+	}
+	return matched
+}
+
+//highlight html escapes the text and wraps the words at indexes in highlight marks
+func (st searchText) highlight(indexes []int) string {
+	sort.Ints(indexes)
+	var builder strings.Builder
+	last := 0
+	for i, index := range indexes {
+		if i > 0 && indexes[i-1] == index {
+			continue
+		}
+		bound := st.bounds[index]
+		builder.WriteString(html.EscapeString(st.text[last:bound[0]]))
+		builder.WriteString(HIGHLIGHT_START + html.EscapeString(st.text[bound[0]:bound[1]]) + HIGHLIGHT_END)
+		last = bound[1]
+	}
+	builder.WriteString(html.EscapeString(st.text[last:]))
+	return builder.String()
+}
+
+//sortSearchResults orders results by descending rank, ties are ordered by item then by occurrence
+func sortSearchResults(results []SearchResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		first, second := results[i], results[j]
+		if first.Rank != second.Rank {
+			return first.Rank > second.Rank
+		}
+		if first.Item.ID != second.Item.ID {
+			return first.Item.ID < second.Item.ID
+		}
+		return first.Item.OccurrenceStart < second.Item.OccurrenceStart
+	})
+}
+
+//searchItem matches item against every term, returning false if any term did not match
+//this is the in memory equivalent of the postgres search, words are compared without stemming
+func searchItem(item MaddenItem, terms []SearchTerm) (SearchResult, bool) {
+	summary, details := newSearchText(item.Summary), newSearchText(item.Details)
+	summaryMatches, detailsMatches := []int{}, []int{}
+	rank := 0.0
+	for _, term := range terms {
+		inSummary, inDetails := summary.matches(term), details.matches(term)
+		if len(inSummary) == 0 && len(inDetails) == 0 {
+			return SearchResult{}, false
+		}
+		rank += summaryWeight*float64(len(inSummary)/len(term.Words)) + detailsWeight*float64(len(inDetails)/len(term.Words))
+		summaryMatches = append(summaryMatches, inSummary...)
+		detailsMatches = append(detailsMatches, inDetails...)
+	}
+	return SearchResult{
+		Item:           item,
+		Rank:           rank,
+		SummarySnippet: summary.highlight(summaryMatches),
+		DetailsSnippet: details.highlight(detailsMatches),
+	}, true
+}
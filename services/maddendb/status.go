@@ -0,0 +1,89 @@
+package maddendb
+
+import (
+	"sort"
+)
+
+//defines the readiness of madden images at an instant, the worst status booked for an image by an active item wins
+
+const (
+	//fully mission capable, also the status of an image no active item books
+	STATUS_FMC = "FMC"
+	//partially mission capable
+	STATUS_PMC = "PMC"
+	//not mission capable
+	STATUS_NMC = "NMC"
+)
+
+//statusRanks orders statuses from best to worst, unknown statuses rank as FMC
+var statusRanks = map[string]int{
+	STATUS_FMC: 0,
+	STATUS_PMC: 1,
+	STATUS_NMC: 2,
+}
+
+//MaddenStatus is the status of every image at an instant along with the worst of them
+type MaddenStatus struct {
+	At int64
+	//the worst status of any image, FMC if there are none
+	Status string
+	//every live image and any image booked by an active item, in order of id
+	Images []ImageStatus
+}
+
+//ImageStatus is the effective status of a single image at an instant
+type ImageStatus struct {
+	MaddenImageFile MaddenImageFile
+	//the worst status given by Windows, FMC if there are none
+	Status string
+	//windows of items active at the instant booking the image in order of start, recurring items are represented by their occurrence
+	Windows []ImageStatusWindow
+}
+
+//ImageStatusWindow is an active item window and the status it gives an image
+type ImageStatusWindow struct {
+	Item   MaddenItem
+	Status string
+}
+
+//WorseStatus returns the worse of two statuses
+func WorseStatus(first, second string) string {
+	if statusRanks[second] > statusRanks[first] {
+		return second
+	}
+	return first
+}
+
+//buildStatus rolls the statuses windows active at at give images up into a MaddenStatus
+//images booked by a window but missing from images are added from the window
+func buildStatus(at int64, images []MaddenImageFile, windows []MaddenItem) MaddenStatus {
+	sort.Slice(windows, func(i, j int) bool {
+		return windowLess(windows[i], windows[j])
+	})
+	statuses := map[uint]*ImageStatus{}
+	for _, image := range images {
+		statuses[image.ID] = &ImageStatus{MaddenImageFile: image, Status: STATUS_FMC, Windows: []ImageStatusWindow{}}
+	}
+	for _, window := range windows {
+		for _, itemImage := range window.ItemImages {
+			id := itemImageFileId(itemImage)
+			status, exists := statuses[id]
+			if !exists {
+				status = &ImageStatus{MaddenImageFile: itemImage.MaddenImageFile, Status: STATUS_FMC, Windows: []ImageStatusWindow{}}
+				status.MaddenImageFile.ID = id
+				statuses[id] = status
+			}
+			status.Status = WorseStatus(status.Status, itemImage.Status)
+			status.Windows = append(status.Windows, ImageStatusWindow{Item: window, Status: itemImage.Status})
+		}
+	}
+	rollup := MaddenStatus{At: at, Status: STATUS_FMC, Images: []ImageStatus{}}
+	for _, status := range statuses {
+		rollup.Status = WorseStatus(rollup.Status, status.Status)
+		rollup.Images = append(rollup.Images, *status)
+	}
+	sort.Slice(rollup.Images, func(i, j int) bool {
+		return rollup.Images[i].MaddenImageFile.ID < rollup.Images[j].MaddenImageFile.ID
+	})
+	return rollup
+}
@@ -0,0 +1,2665 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"../services/maddendb"
+	"github.com/go-playground/assert/v2"
+	"gorm.io/gorm"
+)
+
+//conformance suite, every test is run against each Madden implementation
+
+// backend pairs a Madden implementation with the setup required to give each test a clean store
+type backend struct {
+	name string
+	//setup returns the implementation under test and a teardown to run once the test completes
+	setup func(t *testing.T) (maddendb.Madden, func(t *testing.T))
+}
+
+const (
+	//actor recorded against every revision made by the suite
+	TEST_ACTOR = "tester"
+)
+
+var (
+	//objects under test
+	backends = []backend{
+		{name: "memory", setup: setupMemory},
+		{name: "postgres", setup: setupPostgres},
+	}
+)
+
+//Setup
+
+// forEachBackend runs test as a subtest against every backend
+func forEachBackend(t *testing.T, test func(t *testing.T, madden maddendb.Madden)) {
+	for _, backend := range backends {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			madden, tearDown := backend.setup(t)
+			defer tearDown(t)
+			test(t, madden)
+		})
+	}
+}
+
+func setupMemory(t *testing.T) (maddendb.Madden, func(t *testing.T)) {
+	return maddendb.NewMemoryMadden(), func(t *testing.T) {}
+}
+
+//Tests
+
+func TestDelete(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		err := madden.DeleteMaddenImage(context.Background(), 1, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+		}
+		items, err := madden.GetMaddenImages(context.Background(), 0, 25)
+		if err != nil {
+			t.Errorf("got error while confirming madden items inserted ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 4, len(items))
+		for _, item := range items {
+			assert.NotEqual(t, 1, item.ID)
+		}
+	})
+}
+
+func TestMaddenEntryDelete(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		var removeId = uint(1)
+		_, err := madden.GetMaddenItemById(context.Background(), removeId)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		err = madden.DeleteMaddenItem(context.Background(), removeId, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+		}
+		_, err = madden.GetMaddenItemById(context.Background(), removeId)
+		if err == nil {
+			t.Errorf("error on item delete: %s\n", err.Error())
+			t.FailNow()
+		}
+	})
+}
+
+func TestCreate(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item, err := madden.CreateMaddenItem(context.Background(), createDefaultItem(), false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, "Im a summary", item.Summary)
+	})
+}
+
+func TestCreateOnItemExists(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		t1 := time.Now().UTC().Unix()
+		t2 := time.Now().UTC().Unix()
+		item := maddendb.MaddenItem{BeginDate: t1, EndDate: t2, Summary: "Im a summary", Details: "these are details"}
+		duplicate := maddendb.MaddenItem{BeginDate: t1, EndDate: t2, Summary: "Im a summary", Details: "these are details"}
+		created, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, created.BeginDate, duplicate.BeginDate)
+		assert.Equal(t, duplicate.Summary, item.Summary)
+		assert.Equal(t, duplicate.EndDate, item.EndDate)
+		assert.Equal(t, duplicate.Details, item.Details)
+		assert.Equal(t, duplicate.BeginDate, item.BeginDate)
+		_, err = madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err == nil {
+			t.Errorf("expected error on duplicate insert, but got no error\n")
+			t.FailNow()
+		}
+	})
+}
+
+func TestDuplicateCreateConflicts(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := createDefaultItem()
+		created, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		_, err = madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		conflict, ok := err.(*maddendb.ConflictError)
+		if !ok {
+			t.Errorf("expected conflict error on duplicate insert, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, created.ID, conflict.ExistingId)
+	})
+}
+
+func TestConcurrentCreateSingleWinner(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := createDefaultItem()
+		attempts := 8
+		results := make(chan error, attempts)
+		created := make(chan maddendb.MaddenItem, attempts)
+		var wait sync.WaitGroup
+		for i := 0; i < attempts; i++ {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				inserted, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+				if err == nil {
+					created <- inserted
+				}
+				results <- err
+			}()
+		}
+		wait.Wait()
+		close(results)
+		close(created)
+		conflicts := []*maddendb.ConflictError{}
+		for err := range results {
+			if conflict, ok := err.(*maddendb.ConflictError); ok {
+				conflicts = append(conflicts, conflict)
+			} else if err != nil {
+				t.Errorf("expected only conflict errors got ERROR: %s\n", err.Error())
+			}
+		}
+		assert.Equal(t, 1, len(created))
+		assert.Equal(t, attempts-1, len(conflicts))
+		winner := <-created
+		for _, conflict := range conflicts {
+			assert.Equal(t, winner.ID, conflict.ExistingId)
+		}
+	})
+}
+
+func TestUpdateIntoDuplicateConflicts(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		item, err := madden.GetMaddenItemById(context.Background(), 2)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		original, _ := madden.GetMaddenItemById(context.Background(), 1)
+		item.BeginDate, item.EndDate, item.Summary, item.Details = original.BeginDate, original.EndDate, original.Summary, original.Details
+		_, err = madden.UpdateMaddenItem(context.Background(), item, item.Version, false, TEST_ACTOR)
+		conflict, ok := err.(*maddendb.ConflictError)
+		if !ok {
+			t.Errorf("expected conflict error on duplicate update, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, uint(1), conflict.ExistingId)
+		//an unchanged item does not conflict with itself
+		if _, err := madden.UpdateMaddenItem(context.Background(), original, original.Version, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+		}
+	})
+}
+
+func TestRestoreDuplicateConflicts(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := createDefaultItem()
+		item.ItemImages = nil
+		created, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		if err := madden.DeleteMaddenItem(context.Background(), created.ID, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		item.ID = created.ID + 1
+		recreated, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected deleted items not to conflict got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		_, err = madden.RestoreMaddenItem(context.Background(), created.ID, false, TEST_ACTOR)
+		conflict, ok := err.(*maddendb.ConflictError)
+		if !ok {
+			t.Errorf("expected conflict error on duplicate restore, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, recreated.ID, conflict.ExistingId)
+	})
+}
+
+func TestItemsLastModified(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		ctx := context.Background()
+		empty, err := madden.GetMaddenItemsLastModified(ctx)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, true, empty.IsZero())
+		item := createDefaultItem()
+		created, err := madden.CreateMaddenItem(ctx, item, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		afterCreate, _ := madden.GetMaddenItemsLastModified(ctx)
+		assert.Equal(t, false, afterCreate.IsZero())
+		//deleting and restoring move an item out of and into pages, so both count as changes
+		steps := []struct {
+			name   string
+			change func() error
+		}{
+			{name: "delete", change: func() error { return madden.DeleteMaddenItem(ctx, created.ID, TEST_ACTOR) }},
+			{name: "restore", change: func() error {
+				_, err := madden.RestoreMaddenItem(ctx, created.ID, false, TEST_ACTOR)
+				return err
+			}},
+		}
+		previous := afterCreate
+		for _, step := range steps {
+			time.Sleep(10 * time.Millisecond)
+			if err := step.change(); err != nil {
+				t.Errorf("expected nil error on %s got ERROR: %s\n", step.name, err.Error())
+				t.FailNow()
+			}
+			latest, err := madden.GetMaddenItemsLastModified(ctx)
+			if err != nil {
+				t.Errorf("expected non error but got error: %s\n", err.Error())
+				t.FailNow()
+			}
+			if !latest.After(previous) {
+				t.Errorf("expected %s to move the last modification after %s got %s\n", step.name, previous, latest)
+			}
+			previous = latest
+		}
+	})
+}
+
+func TestCancelledContextAbandonsRequest(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		cancelled, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := madden.CreateMaddenItem(cancelled, createDefaultItem(), false, TEST_ACTOR); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected cancelled create to fail with context.Canceled, got %v\n", err)
+		}
+		if _, err := madden.GetMaddenItems(cancelled, 0, 10, time.Now().Unix(), 0, maddendb.StartDate, false); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected cancelled read to fail with context.Canceled, got %v\n", err)
+		}
+		expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancelExpired()
+		if _, err := madden.GetMaddenItemById(expired, 1); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected expired read to fail with context.DeadlineExceeded, got %v\n", err)
+		}
+		items, err := madden.GetMaddenItems(context.Background(), 0, 10, time.Now().Unix()+1000000, 0, maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 0, len(items))
+	})
+}
+
+func TestValidUpdate(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := createDefaultItem()
+		item.IsHistorical = true
+		inserted, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		fmt.Println("INSERTED ID")
+		fmt.Println(inserted.ID)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.Details, item.Details)
+		assert.Equal(t, inserted.BeginDate, item.BeginDate)
+		assert.Equal(t, inserted.EndDate, item.EndDate)
+		assert.Equal(t, inserted.Summary, item.Summary)
+		assert.Equal(t, inserted.IsHistorical, item.IsHistorical)
+		inserted.Summary = "whoops i needed to update the summary"
+		inserted.IsHistorical = false
+		updated, err := madden.UpdateMaddenItem(context.Background(), inserted, inserted.Version, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.Details, updated.Details)
+		assert.Equal(t, inserted.BeginDate, updated.BeginDate)
+		assert.Equal(t, inserted.EndDate, updated.EndDate)
+		assert.Equal(t, inserted.Summary, updated.Summary)
+		assert.Equal(t, inserted.IsHistorical, updated.IsHistorical)
+	})
+}
+
+func TestStaleUpdateRejected(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		inserted, err := madden.CreateMaddenItem(context.Background(), createDefaultItem(), false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, uint(1), inserted.Version)
+		first := inserted
+		first.Summary = "the first editor updated the summary"
+		updated, err := madden.UpdateMaddenItem(context.Background(), first, inserted.Version, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, uint(2), updated.Version)
+		second := inserted
+		second.Details = "the second editor read the item before the first update"
+		_, err = madden.UpdateMaddenItem(context.Background(), second, inserted.Version, false, TEST_ACTOR)
+		stale, ok := err.(*maddendb.VersionConflictError)
+		if !ok {
+			t.Errorf("expected version conflict error on stale update, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, uint(2), stale.CurrentVersion)
+		stored, _ := madden.GetMaddenItemById(context.Background(), inserted.ID)
+		assert.Equal(t, first.Summary, stored.Summary)
+		assert.Equal(t, inserted.Details, stored.Details)
+	})
+}
+
+func TestConcurrentUpdateSingleWinner(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		inserted, err := madden.CreateMaddenItem(context.Background(), createDefaultItem(), false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		attempts := 8
+		results := make(chan error, attempts)
+		var wait sync.WaitGroup
+		for i := 0; i < attempts; i++ {
+			wait.Add(1)
+			go func(i int) {
+				defer wait.Done()
+				item := inserted
+				item.Summary = fmt.Sprintf("concurrent summary number %d", i)
+				_, err := madden.UpdateMaddenItem(context.Background(), item, inserted.Version, false, TEST_ACTOR)
+				results <- err
+			}(i)
+		}
+		wait.Wait()
+		close(results)
+		succeeded := 0
+		for err := range results {
+			if err == nil {
+				succeeded++
+			} else if _, ok := err.(*maddendb.VersionConflictError); !ok {
+				t.Errorf("expected only version conflict errors got ERROR: %s\n", err.Error())
+			}
+		}
+		assert.Equal(t, 1, succeeded)
+		stored, _ := madden.GetMaddenItemById(context.Background(), inserted.ID)
+		assert.Equal(t, inserted.Version+1, stored.Version)
+	})
+}
+
+func TestInvalidUpdate(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := createDefaultItem()
+		inserted, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.Details, item.Details)
+		assert.Equal(t, inserted.BeginDate, item.BeginDate)
+		assert.Equal(t, inserted.EndDate, item.EndDate)
+		assert.Equal(t, inserted.Summary, item.Summary)
+		inserted.ID = 42
+		_, err = madden.UpdateMaddenItem(context.Background(), item, item.Version, false, TEST_ACTOR)
+		if err == nil {
+			t.Errorf("expected error but got none")
+			t.FailNow()
+		}
+	})
+}
+
+func TestSearch(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		items, err := madden.GetMaddenItems(context.Background(), 0, 10, time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2000, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 4, len(items))
+		for i, item := range items {
+			index := len(items) - i
+			assert.Equal(t, fmt.Sprintf("Item%d", index), item.Summary)
+			assert.Equal(t, fmt.Sprintf("Details%d", index), item.Details)
+			if index-1 < 3 {
+				assert.Equal(t, 1, len(item.ItemImages))
+			} else {
+				assert.Equal(t, 2, len(item.ItemImages))
+			}
+			for j, image := range item.ItemImages {
+				fmt.Println(image.MaddenImageFile)
+				assert.Equal(t, fmt.Sprintf("f%d", j+index), image.MaddenImageFile.FileName)
+			}
+		}
+	})
+}
+
+func TestSort(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertSortTestItems(t, madden)
+		items, err := madden.GetMaddenItems(context.Background(), 0, 10, time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2000, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 4, len(items))
+		assert.Equal(t, "Item3", items[0].Summary)
+		assert.Equal(t, "Item2", items[1].Summary)
+		assert.Equal(t, "Item4", items[2].Summary)
+		assert.Equal(t, "Item1", items[3].Summary)
+	})
+}
+
+func TestItemCursorPaging(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertSortTestItems(t, madden)
+		expected := map[maddendb.SortField][]string{
+			maddendb.StartDate: {"Item3", "Item2", "Item4", "Item1"},
+			maddendb.EndDate:   {"Item3", "Item2", "Item1", "Item4"},
+		}
+		for sortField, summaries := range expected {
+			paged := collectItemPages(t, madden, 1, sortField)
+			assert.Equal(t, summaries, paged)
+			assert.Equal(t, summaries, collectItemPages(t, madden, 3, sortField))
+		}
+	})
+}
+
+func TestItemCursorStableOnInsert(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertSortTestItems(t, madden)
+		start, end := time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2000, 1, 1, 1, 1, 1, 1, time.UTC).Unix()
+		first, err := madden.GetMaddenItemsAfter(context.Background(), nil, 2, start, end, maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		//an item sorting before the cursor must not shift the following page
+		early := createDefaultItem()
+		early.ID = 5
+		early.BeginDate = time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC).Unix()
+		early.EndDate = time.Date(2021, 1, 2, 1, 1, 1, 1, time.UTC).Unix()
+		early.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 1, Status: "FMC"}}
+		if _, err := madden.CreateMaddenItem(context.Background(), early, false, TEST_ACTOR); err != nil {
+			t.Errorf("error on item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		cursor := maddendb.NewItemCursor(first[len(first)-1], maddendb.StartDate)
+		second, err := madden.GetMaddenItemsAfter(context.Background(), &cursor, 2, start, end, maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 2, len(second))
+		assert.Equal(t, "Item4", second[0].Summary)
+		assert.Equal(t, "Item1", second[1].Summary)
+	})
+}
+
+func TestCursorEncoding(t *testing.T) {
+	cursor := maddendb.ItemCursor{SortField: maddendb.EndDate, BeginDate: 10, EndDate: 20, ID: 3}
+	decoded, err := maddendb.DecodeItemCursor(cursor.Encode())
+	if err != nil {
+		t.Errorf("expected cursor to decode got ERROR: %s\n", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, cursor, decoded)
+	_, err = maddendb.DecodeItemCursor("not a cursor")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestSearchRanksSummaryMatches(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertSearchTestItems(t, madden)
+		results := searchItems(t, madden, "hydraulic")
+		assert.Equal(t, 2, len(results))
+		assert.Equal(t, "Hydraulic pump replacement", results[0].Item.Summary)
+		assert.Equal(t, "Radar calibration window", results[1].Item.Summary)
+		assert.Equal(t, true, results[0].Rank > results[1].Rank)
+		assert.Equal(t, true, strings.Contains(results[0].SummarySnippet, "<mark>Hydraulic</mark>"))
+		assert.Equal(t, true, strings.Contains(results[1].DetailsSnippet, "<mark>hydraulic</mark>"))
+		assert.Equal(t, 1, len(results[0].Item.ItemImages))
+	})
+}
+
+func TestSearchPhraseAndPrefix(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertSearchTestItems(t, madden)
+		results := searchItems(t, madden, `"radar calibration"`)
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "Radar calibration window", results[0].Item.Summary)
+		assert.Equal(t, 0, len(searchItems(t, madden, `"calibration radar"`)))
+		results = searchItems(t, madden, "calib*")
+		assert.Equal(t, 1, len(results))
+		//every term must match
+		assert.Equal(t, 1, len(searchItems(t, madden, "hydraulic pump")))
+		_, err := madden.SearchMaddenItems(context.Background(), "\"\" *", 0, 10, time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2000, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), false)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestSearchEscapesSnippets(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertSearchTestItems(t, madden)
+		item := maddendb.MaddenItem{Summary: `<script>alert("pump")</script> & Tom's`, Details: "<b>pump</b> check"}
+		item.ID = 4
+		item.BeginDate = time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC).Unix()
+		item.EndDate = time.Date(2022, 1, 6, 0, 0, 0, 0, time.UTC).Unix()
+		item.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 4, Status: "FMC"}}
+		if _, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR); err != nil {
+			t.Errorf("error on search item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		results := searchItems(t, madden, "alert")
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, "&lt;script&gt;<mark>alert</mark>(&#34;pump&#34;)&lt;/script&gt; &amp; Tom&#39;s", results[0].SummarySnippet)
+		assert.Equal(t, false, strings.Contains(results[0].DetailsSnippet, "<b>"))
+		//the markup of the item is kept as written
+		assert.Equal(t, item.Summary, results[0].Item.Summary)
+	})
+}
+
+func TestSearchRecurringOccurrences(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertSearchTestItems(t, madden)
+		series := insertRecurringItem(t, madden, "FREQ=WEEKLY;COUNT=3")
+		first, second, third := series.BeginDate, time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC).Unix(), time.Date(2022, 1, 17, 10, 0, 0, 0, time.UTC).Unix()
+		edited := maddendb.ItemOccurrence{OccurrenceStart: second, BeginDate: second, EndDate: second + 7200, Summary: "moved pump inspection", Details: series.Details}
+		if _, err := madden.SetMaddenItemOccurrence(context.Background(), series.ID, edited, series.Version, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		//occurrences are listed instead of the series, each with its own text
+		results := searchItems(t, madden, "weekly")
+		assert.Equal(t, 2, len(results))
+		assert.Equal(t, series.ID, results[0].Item.ID)
+		assert.Equal(t, first, results[0].Item.OccurrenceStart)
+		assert.Equal(t, third, results[1].Item.OccurrenceStart)
+		assert.Equal(t, "<mark>weekly</mark> maintenance window", results[0].SummarySnippet)
+		results = searchItems(t, madden, "inspection")
+		assert.Equal(t, 1, len(results))
+		assert.Equal(t, second, results[0].Item.OccurrenceStart)
+		assert.Equal(t, edited.Summary, results[0].Item.Summary)
+		//matching series are merged with matching entries
+		results = searchItems(t, madden, "window")
+		assert.Equal(t, 3, len(results))
+		for _, result := range results {
+			assert.Equal(t, true, result.Item.RRule == "" || result.Item.OccurrenceStart != 0)
+		}
+		//paging walks the merged order
+		page, err := madden.SearchMaddenItems(context.Background(), "weekly", 1, 1, time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2000, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(page))
+		assert.Equal(t, third, page[0].Item.OccurrenceStart)
+	})
+}
+
+func TestSummaryCreate(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		s := maddendb.Summary{Summary: "hello i am a summary"}
+		saved, err := madden.CreateSummary(context.Background(), s)
+		if err != nil {
+			t.Errorf("got error on create summary expected none, ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, saved.Summary, s.Summary)
+	})
+}
+
+func TestSummaryReturnsMostRecent(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		s := maddendb.Summary{Summary: "hello i am a summary"}
+		saved, err := madden.CreateSummary(context.Background(), s)
+		if err != nil {
+			t.Errorf("got error on create summary expected none, ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, saved.Summary, s.Summary)
+		s = maddendb.Summary{Summary: "I now have new text"}
+		_, err = madden.CreateSummary(context.Background(), s)
+		if err != nil {
+			t.Errorf("got error on create summary expected none, ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		shouldBeS, err := madden.GetSummary(context.Background())
+		if err != nil {
+			t.Errorf("got error on summar search, expected none ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, shouldBeS.Summary, s.Summary)
+	})
+}
+
+func TestSingleItemSearch(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		items, err := madden.GetMaddenItems(context.Background(), 3, 1, time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2000, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(items))
+		assert.Equal(t, "Details1", items[0].Details)
+	})
+}
+
+func TestEmptyItems(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		items, err := madden.GetMaddenItems(context.Background(), 0, 20, time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 0, len(items))
+	})
+}
+
+func TestSearchById(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		t1 := time.Now().UTC().Unix()
+		t2 := time.Now().UTC().Unix()
+		item := maddendb.MaddenItem{BeginDate: t1, EndDate: t2, Summary: "Im a summary", Details: "these are details"}
+		inserted, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("error on item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		foundById, err := madden.GetMaddenItemById(context.Background(), inserted.ID)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, foundById.Details, inserted.Details)
+		assert.Equal(t, foundById.ID, inserted.ID)
+	})
+}
+
+func TestErrorOnSearchNoItem(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		t1 := time.Now().UTC().Unix()
+		t2 := time.Now().UTC().Unix()
+		item := maddendb.MaddenItem{BeginDate: t1, EndDate: t2, Summary: "Im a summary", Details: "these are details"}
+		inserted, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("error on item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		_, err = madden.GetMaddenItemById(context.Background(), inserted.ID+1)
+		if err == nil {
+			t.Errorf("expected error on item search but got none")
+			t.FailNow()
+		}
+	})
+}
+
+func TestShouldntInsertWithoutImage(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		t1 := time.Now().UTC().Unix()
+		t2 := time.Now().UTC().Unix()
+		item := maddendb.MaddenItem{BeginDate: t1, EndDate: t2, Summary: "Im a summary", Details: "these are details", ItemImages: []maddendb.ItemImages{{MaddenImageFileId: 5435}}}
+		_, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		if err == nil {
+			t.Errorf("Expected failure on insert where image did not exist but got none")
+		}
+	})
+}
+
+func TestImageInsert(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := maddendb.MaddenImageFile{FileName: "file1", Thumbnail: "thumb1"}
+		inserted, err := madden.CreateMaddenImage(context.Background(), item)
+		if err != nil {
+			t.Errorf("error while inserting item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.FileName, item.FileName)
+	})
+}
+
+func TestInvalidImageInsert(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := maddendb.MaddenImageFile{FileName: "file1", Thumbnail: "thumb1"}
+		inserted, err := madden.CreateMaddenImage(context.Background(), item)
+		if err != nil {
+			t.Errorf("error while inserting item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.FileName, item.FileName)
+		_, err = madden.CreateMaddenImage(context.Background(), inserted)
+		if err == nil {
+			t.Errorf("expected error on duplicate filename insert, got none")
+		}
+		conflict, ok := err.(*maddendb.ConflictError)
+		if !ok {
+			t.Errorf("expected conflict error on duplicate filename insert, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.ID, conflict.ExistingId)
+	})
+}
+
+func TestValidImageUpdate(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := maddendb.MaddenImageFile{FileName: "file1", Thumbnail: "thumb1"}
+		inserted, err := madden.CreateMaddenImage(context.Background(), item)
+		if err != nil {
+			t.Errorf("error while inserting item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.FileName, item.FileName)
+		inserted.FileName = "file2"
+		original, updated, err := madden.UpdateMaddenImage(context.Background(), inserted, inserted.Version)
+		assert.Equal(t, "file1", original.FileName)
+		if err != nil {
+			t.Errorf("error while updating item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.FileName, updated.FileName)
+		assert.Equal(t, inserted.ID, updated.ID)
+	})
+}
+
+func TestInvalidImageUpdate(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		item := maddendb.MaddenImageFile{FileName: "file1", Thumbnail: "thumb1"}
+		inserted, err := madden.CreateMaddenImage(context.Background(), item)
+		if err != nil {
+			t.Errorf("error while inserting item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.FileName, item.FileName)
+		inserted.ID++
+		_, _, err = madden.UpdateMaddenImage(context.Background(), inserted, inserted.Version)
+		if err == nil {
+			t.Errorf("error while updating item expected, but got none\n")
+		}
+	})
+}
+
+func TestMissingImageUpdateNotFound(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		inserted, err := madden.CreateMaddenImage(context.Background(), maddendb.MaddenImageFile{FileName: "file1", Thumbnail: "thumb1"})
+		if err != nil {
+			t.Errorf("error while inserting item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		deleted, err := madden.CreateMaddenImage(context.Background(), maddendb.MaddenImageFile{FileName: "file2", Thumbnail: "thumb2"})
+		if err != nil {
+			t.Errorf("error while inserting item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		if err := madden.DeleteMaddenImage(context.Background(), deleted.ID, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		missing := inserted
+		missing.ID = deleted.ID + 1
+		//callers tell a missing image from a failure by the original error
+		for _, image := range []maddendb.MaddenImageFile{missing, deleted} {
+			_, _, err = madden.UpdateMaddenImage(context.Background(), image, image.Version)
+			dbErr, ok := err.(*maddendb.DbError)
+			if !ok {
+				t.Errorf("expected db error on update of missing image %d, got %v\n", image.ID, err)
+				t.FailNow()
+			}
+			assert.Equal(t, gorm.ErrRecordNotFound, dbErr.OriginalError)
+		}
+	})
+}
+
+func TestStaleImageUpdateRejected(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		inserted, err := madden.CreateMaddenImage(context.Background(), maddendb.MaddenImageFile{FileName: "file1", Thumbnail: "thumb1"})
+		if err != nil {
+			t.Errorf("error while inserting item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		inserted.FileName = "file2"
+		_, updated, err := madden.UpdateMaddenImage(context.Background(), inserted, inserted.Version)
+		if err != nil {
+			t.Errorf("error while updating item ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, inserted.Version+1, updated.Version)
+		inserted.FileName = "file3"
+		_, _, err = madden.UpdateMaddenImage(context.Background(), inserted, inserted.Version)
+		stale, ok := err.(*maddendb.VersionConflictError)
+		if !ok {
+			t.Errorf("expected version conflict error on stale update, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, updated.Version, stale.CurrentVersion)
+	})
+}
+
+func TestImageSearch(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		items, err := madden.GetMaddenImages(context.Background(), 0, 10)
+		if err != nil {
+			t.Errorf("error on image search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 5, len(items))
+		for i, item := range items {
+			assert.Equal(t, fmt.Sprintf("f%d", i+1), item.FileName)
+		}
+	})
+}
+
+func TestPagedImageSearch(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		items, err := madden.GetMaddenImages(context.Background(), 0, 1)
+		if err != nil {
+			t.Errorf("error on image search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(items))
+		for i, item := range items {
+			assert.Equal(t, fmt.Sprintf("f%d", i+1), item.FileName)
+		}
+	})
+}
+
+func TestNonOnePagedImageSearch(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		items, err := madden.GetMaddenImages(context.Background(), 1, 1)
+		if err != nil {
+			t.Errorf("error on image search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(items))
+		assert.Equal(t, "f2", items[0].FileName)
+	})
+}
+
+func TestImageCursorPaging(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		names := []string{}
+		var cursor *maddendb.ImageCursor
+		for {
+			images, err := madden.GetMaddenImagesAfter(context.Background(), cursor, 2)
+			if err != nil {
+				t.Errorf("error on image search ERROR: %s\n", err.Error())
+				t.FailNow()
+			}
+			if len(images) == 0 {
+				break
+			}
+			for _, image := range images {
+				names = append(names, image.FileName)
+			}
+			next := maddendb.NewImageCursor(images[len(images)-1])
+			cursor = &next
+		}
+		assert.Equal(t, []string{"f1", "f2", "f3", "f4", "f5"}, names)
+	})
+}
+
+func TestImageSearchByName(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		items, err := madden.GetMaddenImagesByName(context.Background(), 0, 10, "f1")
+		if err != nil {
+			t.Errorf("error on image search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(items))
+		for i, item := range items {
+			assert.Equal(t, fmt.Sprintf("f%d", i+1), item.FileName)
+		}
+	})
+}
+
+func TestImageSearchByNameAll(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		items, err := madden.GetMaddenImagesByName(context.Background(), 0, 10, "f")
+		if err != nil {
+			t.Errorf("error on image search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 5, len(items))
+		for i, item := range items {
+			assert.Equal(t, fmt.Sprintf("f%d", i+1), item.FileName)
+		}
+	})
+}
+
+func TestImageUsages(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		usages, err := madden.GetMaddenImageUsages(context.Background(), 1)
+		if err != nil {
+			t.Errorf("error on usage search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		ids := []uint{}
+		for _, item := range usages {
+			ids = append(ids, item.ID)
+		}
+		assert.Equal(t, []uint{1}, ids)
+		assert.Equal(t, "Item1", usages[0].Summary)
+		//deleted items no longer use their images
+		if err := madden.DeleteMaddenItem(context.Background(), 1, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		usages, _ = madden.GetMaddenImageUsages(context.Background(), 1)
+		assert.Equal(t, 0, len(usages))
+		usages, _ = madden.GetMaddenImageUsages(context.Background(), 42)
+		assert.Equal(t, 0, len(usages))
+	})
+}
+
+func TestFindOrCreateImageByContent(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		hash := strings.Repeat("ab", 32)
+		image := maddendb.MaddenImageFile{FileName: hash + ".png", Thumbnail: hash + "_thumb.png", ContentHash: hash, Size: 2048, MimeType: "image/png", Width: 640, Height: 480}
+		created, isNew, err := madden.FindOrCreateMaddenImage(context.Background(), image)
+		if err != nil {
+			t.Errorf("expected nil error on create got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, true, isNew)
+		assert.Equal(t, int64(2048), created.Size)
+		assert.Equal(t, "image/png", created.MimeType)
+		assert.Equal(t, 640, created.Width)
+		assert.Equal(t, 480, created.Height)
+		found, isNew, err := madden.FindOrCreateMaddenImage(context.Background(), image)
+		if err != nil {
+			t.Errorf("expected nil error on repeat got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, false, isNew)
+		assert.Equal(t, created.ID, found.ID)
+		//identical content in the trash is restored rather than stored twice
+		if err := madden.DeleteMaddenImage(context.Background(), created.ID, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		restored, isNew, err := madden.FindOrCreateMaddenImage(context.Background(), image)
+		if err != nil {
+			t.Errorf("expected nil error on restore got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, false, isNew)
+		assert.Equal(t, created.ID, restored.ID)
+		images, _ := madden.GetMaddenImagesByName(context.Background(), 0, 10, hash)
+		assert.Equal(t, 1, len(images))
+		_, _, err = madden.FindOrCreateMaddenImage(context.Background(), maddendb.MaddenImageFile{FileName: "nohash.png", Thumbnail: "nohash_thumb.png"})
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestConcurrentFindOrCreateSingleImage(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		hash := strings.Repeat("cd", 32)
+		image := maddendb.MaddenImageFile{FileName: hash + ".jpg", Thumbnail: hash + "_thumb.jpg", ContentHash: hash}
+		const writers = 8
+		ids := make(chan uint, writers)
+		created := make(chan bool, writers)
+		wg := sync.WaitGroup{}
+		for i := 0; i < writers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				found, isNew, err := madden.FindOrCreateMaddenImage(context.Background(), image)
+				if err != nil {
+					t.Errorf("expected nil error got ERROR: %s\n", err.Error())
+					return
+				}
+				ids <- found.ID
+				created <- isNew
+			}()
+		}
+		wg.Wait()
+		close(ids)
+		close(created)
+		distinct := map[uint]bool{}
+		for id := range ids {
+			distinct[id] = true
+		}
+		creates := 0
+		for isNew := range created {
+			if isNew {
+				creates++
+			}
+		}
+		assert.Equal(t, 1, len(distinct))
+		assert.Equal(t, 1, creates)
+	})
+}
+
+func TestUpdateReplacesImages(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		item, err := madden.GetMaddenItemById(context.Background(), 4)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 2, len(item.ItemImages))
+		item.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 1, Status: "NMC"}}
+		updated, err := madden.UpdateMaddenItem(context.Background(), item, item.Version, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(updated.ItemImages))
+		assert.Equal(t, "f1", updated.ItemImages[0].MaddenImageFile.FileName)
+		assert.Equal(t, "NMC", updated.ItemImages[0].Status)
+	})
+}
+
+func TestDeleteUsedImageRefused(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		err := madden.DeleteMaddenImage(context.Background(), 1, false, TEST_ACTOR)
+		inUse := &maddendb.ImageInUseError{}
+		if !errors.As(err, &inUse) {
+			t.Errorf("expected image in use error got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, []uint{1}, inUse.ItemIds)
+		image, err := madden.GetMaddenImageById(context.Background(), 1)
+		if err != nil {
+			t.Errorf("expected refused delete to keep the image got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, "f1", image.FileName)
+		//a deleted image can not be linked
+		item := createDefaultItem()
+		item.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 2, Status: "FMC"}}
+		if err := madden.DeleteMaddenItem(context.Background(), 2, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		if err := madden.DeleteMaddenImage(context.Background(), 2, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected image used only by trashed items to delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		_, err = madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestDeleteUsedImageCascade(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		if err := madden.DeleteMaddenImage(context.Background(), 5, true, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on cascading delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		_, err := madden.GetMaddenImageById(context.Background(), 5)
+		assert.NotEqual(t, nil, err)
+		item, err := madden.GetMaddenItemById(context.Background(), 4)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		//the other image of the item is kept
+		assert.Equal(t, 1, len(item.ItemImages))
+		assert.Equal(t, "f4", item.ItemImages[0].MaddenImageFile.FileName)
+		assert.Equal(t, uint(2), item.Version)
+		revisions, _ := madden.GetMaddenItemRevisions(context.Background(), 4)
+		assert.Equal(t, 2, len(revisions))
+		assert.Equal(t, maddendb.REVISION_UPDATE, revisions[1].Action)
+		dangling, err := madden.GetDanglingItemImages(context.Background())
+		if err != nil {
+			t.Errorf("error on dangling search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 0, len(dangling))
+		removed, err := madden.RemoveDanglingItemImages(context.Background(), TEST_ACTOR)
+		if err != nil {
+			t.Errorf("error on dangling repair ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 0, len(removed))
+	})
+}
+
+func TestAllImagesIncludeDeleted(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		if err := madden.DeleteMaddenImage(context.Background(), 3, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		images, err := madden.GetAllMaddenImages(context.Background())
+		if err != nil {
+			t.Errorf("error on image listing ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		names := []string{}
+		for _, image := range images {
+			names = append(names, image.FileName)
+		}
+		assert.Equal(t, []string{"f1", "f2", "f3", "f4", "f5"}, names)
+	})
+}
+
+func TestImportCreatesThenSkips(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		result, err := madden.ImportMadden(context.Background(), importTestRecords("t1", "FMC"), maddendb.ImportSkip, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected nil error on import got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, maddendb.ImportResult{Created: 6, Errors: []maddendb.ImportLineError{}}, result)
+		items, err := madden.GetMaddenItemsAfterId(context.Background(), 0, 10)
+		if err != nil {
+			t.Errorf("error on item listing ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 2, len(items))
+		//image references are mapped from the ids of the import to the stored images
+		assert.Equal(t, "f1", items[0].ItemImages[0].MaddenImageFile.FileName)
+		assert.Equal(t, 2, len(items[1].ItemImages))
+		later, _ := madden.GetMaddenItemsAfterId(context.Background(), items[0].ID, 10)
+		assert.Equal(t, 1, len(later))
+		summary, _ := madden.GetSummary(context.Background())
+		assert.Equal(t, "imported summary", summary.Summary)
+		published, _ := madden.GetPublished(context.Background())
+		assert.Equal(t, true, published.Published)
+		result, err = madden.ImportMadden(context.Background(), importTestRecords("t1", "FMC"), maddendb.ImportSkip, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected nil error on repeated import got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, maddendb.ImportResult{Skipped: 6, Errors: []maddendb.ImportLineError{}}, result)
+		revisions, _ := madden.GetMaddenItemRevisions(context.Background(), items[0].ID)
+		assert.Equal(t, 1, len(revisions))
+	})
+}
+
+func TestImportUpsertUpdatesMatches(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		if _, err := madden.ImportMadden(context.Background(), importTestRecords("t1", "FMC"), maddendb.ImportSkip, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on import got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		//skip leaves the differing records untouched
+		result, _ := madden.ImportMadden(context.Background(), importTestRecords("t1b", "PMC"), maddendb.ImportSkip, false, TEST_ACTOR)
+		assert.Equal(t, maddendb.ImportResult{Skipped: 6, Errors: []maddendb.ImportLineError{}}, result)
+		result, err := madden.ImportMadden(context.Background(), importTestRecords("t1b", "PMC"), maddendb.ImportUpsert, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected nil error on upsert got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, maddendb.ImportResult{Updated: 2, Skipped: 4, Errors: []maddendb.ImportLineError{}}, result)
+		items, _ := madden.GetMaddenItemsAfterId(context.Background(), 0, 10)
+		assert.Equal(t, 2, len(items))
+		assert.Equal(t, "PMC", items[0].ItemImages[0].Status)
+		assert.Equal(t, "t1b", items[0].ItemImages[0].MaddenImageFile.Thumbnail)
+		assert.Equal(t, uint(2), items[0].Version)
+		revisions, _ := madden.GetMaddenItemRevisions(context.Background(), items[0].ID)
+		assert.Equal(t, 2, len(revisions))
+		assert.Equal(t, uint(1), items[1].Version)
+	})
+}
+
+func TestImportFailureWritesNothing(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		records := importTestRecords("t1", "FMC")
+		missing := createDefaultItem()
+		missing.ItemImages = []maddendb.ItemImages{{Status: "FMC", MaddenImageFileId: 99}}
+		records = append(records, maddendb.ImportRecord{Line: 7, Item: &missing})
+		result, err := madden.ImportMadden(context.Background(), records, maddendb.ImportSkip, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected nil error on failed import got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(result.Errors))
+		assert.Equal(t, 7, result.Errors[0].Line)
+		assert.Equal(t, 6, result.Created)
+		images, _ := madden.GetAllMaddenImages(context.Background())
+		assert.Equal(t, 0, len(images))
+		items, _ := madden.GetMaddenItemsAfterId(context.Background(), 0, 10)
+		assert.Equal(t, 0, len(items))
+		summary, _ := madden.GetSummary(context.Background())
+		assert.Equal(t, "", summary.Summary)
+	})
+}
+
+func TestImportDryRunWritesNothing(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		result, err := madden.ImportMadden(context.Background(), importTestRecords("t1", "FMC"), maddendb.ImportSkip, true, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected nil error on dry run got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, maddendb.ImportResult{Created: 6, Errors: []maddendb.ImportLineError{}}, result)
+		images, _ := madden.GetAllMaddenImages(context.Background())
+		assert.Equal(t, 0, len(images))
+		published, _ := madden.GetPublished(context.Background())
+		assert.Equal(t, false, published.Published)
+	})
+}
+
+func TestRevisionsRecorded(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		item, err := madden.GetMaddenItemById(context.Background(), 1)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		item.Summary = "updated summary"
+		if _, err := madden.UpdateMaddenItem(context.Background(), item, item.Version, false, "updater"); err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		if err := madden.DeleteMaddenItem(context.Background(), 1, "deleter"); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		revisions, err := madden.GetMaddenItemRevisions(context.Background(), 1)
+		if err != nil {
+			t.Errorf("error on revision search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 3, len(revisions))
+		expected := []struct {
+			action string
+			actor  string
+		}{{maddendb.REVISION_CREATE, TEST_ACTOR}, {maddendb.REVISION_UPDATE, "updater"}, {maddendb.REVISION_DELETE, "deleter"}}
+		for i, revision := range revisions {
+			assert.Equal(t, uint(i+1), revision.Revision)
+			assert.Equal(t, expected[i].action, revision.Action)
+			assert.Equal(t, expected[i].actor, revision.Actor)
+		}
+		changes, err := revisions[1].GetChanges()
+		if err != nil {
+			t.Errorf("error decoding changes ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(changes))
+		assert.Equal(t, maddendb.FIELD_SUMMARY, changes[0].Field)
+		assert.Equal(t, "Item1", changes[0].From)
+		assert.Equal(t, "updated summary", changes[0].To)
+		snapshot, err := revisions[2].GetSnapshot()
+		if err != nil {
+			t.Errorf("error decoding snapshot ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, true, snapshot.Deleted)
+		assert.Equal(t, "updated summary", snapshot.Summary)
+		assert.Equal(t, 1, len(snapshot.Images))
+		assert.Equal(t, "f1", snapshot.Images[0].FileName)
+	})
+}
+
+func TestRevisionLookup(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		revision, err := madden.GetMaddenItemRevision(context.Background(), 2, 1)
+		if err != nil {
+			t.Errorf("error on revision search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, uint(2), revision.MaddenItemId)
+		assert.Equal(t, maddendb.REVISION_CREATE, revision.Action)
+		_, err = madden.GetMaddenItemRevision(context.Background(), 2, 2)
+		assert.NotEqual(t, nil, err)
+		_, err = madden.GetMaddenItemRevisions(context.Background(), 100)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestNoRevisionForMissingDelete(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		if err := madden.DeleteMaddenItem(context.Background(), 100, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		_, err := madden.GetMaddenItemRevisions(context.Background(), 100)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestTrashListing(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		for _, id := range []uint{2, 3} {
+			if err := madden.DeleteMaddenItem(context.Background(), id, TEST_ACTOR); err != nil {
+				t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+				t.FailNow()
+			}
+		}
+		if err := madden.DeleteMaddenImage(context.Background(), 5, true, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		items, err := madden.GetDeletedMaddenItems(context.Background(), 0, 10)
+		if err != nil {
+			t.Errorf("error on trash search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 2, len(items))
+		//most recently deleted first
+		assert.Equal(t, uint(3), items[0].ID)
+		assert.Equal(t, uint(2), items[1].ID)
+		assert.Equal(t, 1, len(items[0].ItemImages))
+		images, err := madden.GetDeletedMaddenImages(context.Background(), 0, 10)
+		if err != nil {
+			t.Errorf("error on trash search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(images))
+		assert.Equal(t, "f5", images[0].FileName)
+	})
+}
+
+func TestRestoreItem(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		if err := madden.DeleteMaddenItem(context.Background(), 4, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		if err := madden.DeleteMaddenImage(context.Background(), 5, true, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		restored, err := madden.RestoreMaddenItem(context.Background(), 4, false, "restorer")
+		if err != nil {
+			t.Errorf("expected nil error on restore got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, "Item4", restored.Summary)
+		assert.Equal(t, 2, len(restored.ItemImages))
+		for _, itemImage := range restored.ItemImages {
+			assert.NotEqual(t, "", itemImage.MaddenImageFile.FileName)
+		}
+		if _, err := madden.GetMaddenItemById(context.Background(), 4); err != nil {
+			t.Errorf("expected restored item to be found got ERROR: %s\n", err.Error())
+		}
+		revisions, err := madden.GetMaddenItemRevisions(context.Background(), 4)
+		if err != nil {
+			t.Errorf("error on revision search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		last := revisions[len(revisions)-1]
+		assert.Equal(t, maddendb.REVISION_RESTORE, last.Action)
+		assert.Equal(t, "restorer", last.Actor)
+		_, err = madden.RestoreMaddenItem(context.Background(), 4, false, TEST_ACTOR)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultItems(t, madden)
+		if err := madden.DeleteMaddenItem(context.Background(), 2, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		//image 2 is only linked to the purged item, image 1 is removed from the live item linking it
+		for _, id := range []uint{1, 2} {
+			if err := madden.DeleteMaddenImage(context.Background(), id, true, TEST_ACTOR); err != nil {
+				t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+				t.FailNow()
+			}
+		}
+		purged, err := madden.PurgeDeleted(context.Background(), time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Errorf("expected nil error on purge got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, maddendb.PurgeResult{}, purged)
+		purged, err = madden.PurgeDeleted(context.Background(), time.Now().Add(time.Hour))
+		if err != nil {
+			t.Errorf("expected nil error on purge got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, maddendb.PurgeResult{Items: 1, Images: 2}, purged)
+		items, _ := madden.GetDeletedMaddenItems(context.Background(), 0, 10)
+		assert.Equal(t, 0, len(items))
+		images, _ := madden.GetDeletedMaddenImages(context.Background(), 0, 10)
+		assert.Equal(t, 0, len(images))
+		//history outlives the purged item
+		revisions, err := madden.GetMaddenItemRevisions(context.Background(), 2)
+		if err != nil {
+			t.Errorf("expected history of purged item got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 2, len(revisions))
+	})
+}
+
+func TestWebhookDeliveriesQueued(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		entries := maddendb.WebhookSubscription{Url: "http://example.com/entries", Secret: "0123456789abcdef"}
+		entries.SetEvents([]string{maddendb.EVENT_ENTRY_CREATED, maddendb.EVENT_ENTRY_DELETED})
+		entries, err := madden.CreateWebhook(context.Background(), entries)
+		if err != nil {
+			t.Errorf("error creating webhook ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		everything, err := madden.CreateWebhook(context.Background(), maddendb.WebhookSubscription{Url: "http://example.com/all", Secret: "0123456789abcdef"})
+		if err != nil {
+			t.Errorf("error creating webhook ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		insertDefaultItems(t, madden)
+		item, _ := madden.GetMaddenItemById(context.Background(), 1)
+		item.Summary = "updated summary"
+		if _, err := madden.UpdateMaddenItem(context.Background(), item, item.Version, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		if err := madden.DeleteMaddenItem(context.Background(), 1, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		//an unchanged summary or published state announces nothing
+		for i := 0; i < 2; i++ {
+			if _, err := madden.CreateSummary(context.Background(), maddendb.Summary{Summary: "all systems up"}); err != nil {
+				t.Errorf("error creating summary ERROR: %s\n", err.Error())
+				t.FailNow()
+			}
+			if _, err := madden.CreatePublished(context.Background(), maddendb.Published{Published: true}); err != nil {
+				t.Errorf("error creating published ERROR: %s\n", err.Error())
+				t.FailNow()
+			}
+		}
+		deliveries, err := madden.GetWebhookDeliveries(context.Background(), entries.ID, "", 0, 20)
+		if err != nil {
+			t.Errorf("error listing deliveries ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 5, len(deliveries))
+		assert.Equal(t, maddendb.EVENT_ENTRY_DELETED, deliveries[0].Event)
+		assert.Equal(t, maddendb.DELIVERY_PENDING, deliveries[0].Status)
+		payload := maddendb.WebhookPayload{}
+		if err := json.Unmarshal([]byte(deliveries[0].Payload), &payload); err != nil {
+			t.Errorf("error decoding payload ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, "entry-1-revision-3", payload.Id)
+		assert.Equal(t, maddendb.EVENT_ENTRY_DELETED, payload.Event)
+		deliveries, err = madden.GetWebhookDeliveries(context.Background(), everything.ID, "", 0, 20)
+		if err != nil {
+			t.Errorf("error listing deliveries ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 8, len(deliveries))
+		assert.Equal(t, maddendb.EVENT_PUBLISHED_CHANGED, deliveries[0].Event)
+		assert.Equal(t, maddendb.EVENT_SUMMARY_CHANGED, deliveries[1].Event)
+		assert.Equal(t, maddendb.EVENT_ENTRY_UPDATED, deliveries[3].Event)
+	})
+}
+
+func TestWebhookDeliveryLifecycle(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		webhook, err := madden.CreateWebhook(context.Background(), maddendb.WebhookSubscription{Url: "http://example.com/all", Secret: "0123456789abcdef"})
+		if err != nil {
+			t.Errorf("error creating webhook ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		if _, err := madden.CreateSummary(context.Background(), maddendb.Summary{Summary: "all systems up"}); err != nil {
+			t.Errorf("error creating summary ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		now := time.Now().Add(time.Second)
+		claimed, err := madden.ClaimWebhookDeliveries(context.Background(), now, 10, time.Minute)
+		if err != nil {
+			t.Errorf("error claiming deliveries ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(claimed))
+		assert.Equal(t, webhook.Url, claimed[0].WebhookSubscription.Url)
+		//a claimed delivery is leased
+		leased, _ := madden.ClaimWebhookDeliveries(context.Background(), now, 10, time.Minute)
+		assert.Equal(t, 0, len(leased))
+		retryAt := now.Add(time.Hour)
+		if err := madden.FailWebhookDelivery(context.Background(), claimed[0].ID, "connection refused", &retryAt); err != nil {
+			t.Errorf("error failing delivery ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		waiting, _ := madden.ClaimWebhookDeliveries(context.Background(), now.Add(2*time.Minute), 10, time.Minute)
+		assert.Equal(t, 0, len(waiting))
+		retried, _ := madden.ClaimWebhookDeliveries(context.Background(), retryAt, 10, time.Minute)
+		assert.Equal(t, 1, len(retried))
+		if err := madden.FailWebhookDelivery(context.Background(), claimed[0].ID, "connection refused", nil); err != nil {
+			t.Errorf("error failing delivery ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		dead, _ := madden.GetWebhookDeliveries(context.Background(), webhook.ID, maddendb.DELIVERY_DEAD, 0, 10)
+		assert.Equal(t, 1, len(dead))
+		assert.Equal(t, uint(2), dead[0].Attempts)
+		assert.Equal(t, "connection refused", dead[0].LastError)
+		redelivered, err := madden.RedeliverWebhookDelivery(context.Background(), webhook.ID, claimed[0].ID)
+		if err != nil {
+			t.Errorf("error redelivering ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, maddendb.DELIVERY_PENDING, redelivered.Status)
+		assert.Equal(t, uint(0), redelivered.Attempts)
+		_, err = madden.RedeliverWebhookDelivery(context.Background(), webhook.ID+1, claimed[0].ID)
+		assert.NotEqual(t, nil, err)
+		claimed, _ = madden.ClaimWebhookDeliveries(context.Background(), now, 10, time.Minute)
+		assert.Equal(t, 1, len(claimed))
+		if err := madden.CompleteWebhookDelivery(context.Background(), claimed[0].ID, now); err != nil {
+			t.Errorf("error completing delivery ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		delivered, _ := madden.GetWebhookDeliveries(context.Background(), webhook.ID, maddendb.DELIVERY_DELIVERED, 0, 10)
+		assert.Equal(t, 1, len(delivered))
+		assert.NotEqual(t, nil, delivered[0].DeliveredAt)
+		//deliveries to a deleted subscription are never claimed
+		if _, err := madden.CreateSummary(context.Background(), maddendb.Summary{Summary: "all systems down"}); err != nil {
+			t.Errorf("error creating summary ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		if err := madden.DeleteWebhook(context.Background(), webhook.ID); err != nil {
+			t.Errorf("error deleting webhook ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		orphaned, _ := madden.ClaimWebhookDeliveries(context.Background(), now, 10, time.Minute)
+		assert.Equal(t, 0, len(orphaned))
+		_, err = madden.GetWebhookById(context.Background(), webhook.ID)
+		assert.NotEqual(t, nil, err)
+	})
+}
+
+func TestChangeEventsRecorded(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		latest, err := madden.GetLatestChangeEventId(context.Background())
+		if err != nil {
+			t.Errorf("error reading latest change event ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		notify := make(chan struct{}, 1)
+		go madden.ListenChangeEvents(ctx, notify)
+		awaitNotification(t, notify)
+		insertDefaultItems(t, madden)
+		//every summary is announced, published only when it toggles
+		for i := 0; i < 2; i++ {
+			if _, err := madden.CreateSummary(context.Background(), maddendb.Summary{Summary: "all systems up"}); err != nil {
+				t.Errorf("error creating summary ERROR: %s\n", err.Error())
+				t.FailNow()
+			}
+			if _, err := madden.CreatePublished(context.Background(), maddendb.Published{Published: true}); err != nil {
+				t.Errorf("error creating published ERROR: %s\n", err.Error())
+				t.FailNow()
+			}
+		}
+		awaitNotification(t, notify)
+		events, err := madden.GetChangeEvents(context.Background(), latest, 100)
+		if err != nil {
+			t.Errorf("error listing change events ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		expected := []string{
+			maddendb.EVENT_ENTRY_CREATED, maddendb.EVENT_ENTRY_CREATED, maddendb.EVENT_ENTRY_CREATED, maddendb.EVENT_ENTRY_CREATED,
+			maddendb.EVENT_SUMMARY_CREATED, maddendb.EVENT_PUBLISHED_CHANGED, maddendb.EVENT_SUMMARY_CREATED,
+		}
+		assert.Equal(t, len(expected), len(events))
+		for i, event := range events {
+			assert.Equal(t, expected[i], event.Event)
+			if i > 0 && event.ID <= events[i-1].ID {
+				t.Errorf("change event ids out of order %d after %d\n", event.ID, events[i-1].ID)
+			}
+		}
+		payload := maddendb.WebhookPayload{}
+		if err := json.Unmarshal([]byte(events[5].Payload), &payload); err != nil {
+			t.Errorf("error decoding payload ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, maddendb.EVENT_PUBLISHED_CHANGED, payload.Event)
+		after, _ := madden.GetChangeEvents(context.Background(), events[3].ID, 2)
+		assert.Equal(t, 2, len(after))
+		assert.Equal(t, events[4].ID, after[0].ID)
+		newest, _ := madden.GetLatestChangeEventId(context.Background())
+		assert.Equal(t, events[6].ID, newest)
+	})
+}
+
+func TestPurgeChangeEvents(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		if _, err := madden.CreateSummary(context.Background(), maddendb.Summary{Summary: "all systems up"}); err != nil {
+			t.Errorf("error creating summary ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		purged, err := madden.PurgeChangeEvents(context.Background(), time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Errorf("expected nil error on purge got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, int64(0), purged)
+		purged, err = madden.PurgeChangeEvents(context.Background(), time.Now().Add(time.Hour))
+		if err != nil {
+			t.Errorf("expected nil error on purge got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, int64(1), purged)
+		events, _ := madden.GetChangeEvents(context.Background(), 0, 10)
+		assert.Equal(t, 0, len(events))
+	})
+}
+
+func TestHistoricizeItems(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		never, err := madden.GetJobRun(context.Background(), maddendb.JOB_HISTORICIZE)
+		if err != nil {
+			t.Errorf("error reading job run ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, true, never.StartedAt.IsZero())
+		insertDefaultItems(t, madden)
+		cutoff := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+		run, ran, err := madden.HistoricizeMaddenItems(context.Background(), cutoff, "historicizer")
+		if err != nil {
+			t.Errorf("error historicizing ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, true, ran)
+		assert.Equal(t, int64(3), run.Changed)
+		assert.Equal(t, int64(3), run.TotalChanged)
+		assert.Equal(t, cutoff.Unix(), run.Cutoff.Unix())
+		current, _ := madden.GetMaddenItemById(context.Background(), 1)
+		assert.Equal(t, false, current.IsHistorical)
+		ended, _ := madden.GetMaddenItemById(context.Background(), 2)
+		assert.Equal(t, true, ended.IsHistorical)
+		assert.Equal(t, uint(2), ended.Version)
+		assert.Equal(t, 1, len(ended.ItemImages))
+		revisions, _ := madden.GetMaddenItemRevisions(context.Background(), 2)
+		assert.Equal(t, 2, len(revisions))
+		assert.Equal(t, "historicizer", revisions[1].Actor)
+		changes, _ := revisions[1].GetChanges()
+		assert.Equal(t, 1, len(changes))
+		assert.Equal(t, maddendb.FIELD_HISTORICAL, changes[0].Field)
+		//deleted items are left alone and a repeated run changes nothing
+		if err := madden.DeleteMaddenItem(context.Background(), 1, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		run, ran, err = madden.HistoricizeMaddenItems(context.Background(), time.Now(), "historicizer")
+		if err != nil {
+			t.Errorf("error historicizing ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, true, ran)
+		assert.Equal(t, int64(0), run.Changed)
+		assert.Equal(t, int64(3), run.TotalChanged)
+		latest, _ := madden.GetJobRun(context.Background(), maddendb.JOB_HISTORICIZE)
+		assert.Equal(t, run.Changed, latest.Changed)
+		assert.Equal(t, run.TotalChanged, latest.TotalChanged)
+		deleted, _ := madden.GetDeletedMaddenItems(context.Background(), 0, 10)
+		assert.Equal(t, 1, len(deleted))
+		assert.Equal(t, false, deleted[0].IsHistorical)
+	})
+}
+
+func TestParseRRule(t *testing.T) {
+	for _, rule := range []string{
+		"FREQ=DAILY",
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10",
+		"freq=monthly;byday=-1fr;until=20221231",
+		"FREQ=YEARLY;BYMONTH=3;BYDAY=2SU;UNTIL=20300101T000000Z",
+		"FREQ=MONTHLY;BYMONTHDAY=1,15,-1;WKST=SU",
+	} {
+		if _, err := maddendb.ParseRRule(rule); err != nil {
+			t.Errorf("expected %q to parse but got error: %s\n", rule, err.Error())
+		}
+	}
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;COUNT=2;UNTIL=20220101",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=3",
+		"FREQ=YEARLY;BYDAY=1MO",
+		"FREQ=DAILY;BYSETPOS=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+	} {
+		if _, err := maddendb.ParseRRule(rule); err == nil {
+			t.Errorf("expected %q to be rejected\n", rule)
+		}
+	}
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	expected := map[string][]time.Time{
+		//the first Tuesday of each month
+		"FREQ=MONTHLY;BYDAY=1TU;COUNT=3": {
+			time.Date(2022, 1, 4, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 2, 1, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC),
+		},
+		//every other week on Monday and Wednesday, the Monday of the start counts towards COUNT
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=4": {
+			time.Date(2022, 1, 4, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 1, 5, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 1, 17, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 1, 19, 10, 0, 0, 0, time.UTC),
+		},
+		"FREQ=DAILY;INTERVAL=3;UNTIL=20220110": {
+			time.Date(2022, 1, 4, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 1, 7, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC),
+		},
+	}
+	start := time.Date(2022, 1, 4, 10, 0, 0, 0, time.UTC).Unix()
+	for rule, occurrences := range expected {
+		recurrence, err := maddendb.ParseRRule(rule)
+		if err != nil {
+			t.Errorf("expected %q to parse but got error: %s\n", rule, err.Error())
+			continue
+		}
+		visited := []time.Time{}
+		complete := recurrence.Each(start, func(occurrenceStart int64) bool {
+			visited = append(visited, time.Unix(occurrenceStart, 0).UTC())
+			return true
+		})
+		assert.Equal(t, true, complete)
+		assert.Equal(t, occurrences, visited)
+		assert.Equal(t, true, recurrence.Includes(start, occurrences[len(occurrences)-1].Unix()))
+		assert.Equal(t, false, recurrence.Includes(start, occurrences[0].Add(time.Hour).Unix()))
+	}
+}
+
+func TestRecurringItemExpansion(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		series := insertRecurringItem(t, madden, "FREQ=WEEKLY;COUNT=5")
+		single := createDefaultItem()
+		single.Summary = "single entry between occurrences"
+		single.BeginDate = time.Date(2022, 1, 12, 0, 0, 0, 0, time.UTC).Unix()
+		single.EndDate = time.Date(2022, 1, 12, 1, 0, 0, 0, time.UTC).Unix()
+		single.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 2, Status: "FMC"}}
+		if _, err := madden.CreateMaddenItem(context.Background(), single, false, TEST_ACTOR); err != nil {
+			t.Errorf("error on item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		//occurrences starting before the 20th and ending after the 5th
+		start, end := time.Date(2022, 1, 20, 0, 0, 0, 0, time.UTC).Unix(), time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC).Unix()
+		items, err := madden.GetMaddenItems(context.Background(), 0, 10, start, end, maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 3, len(items))
+		assert.Equal(t, time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC).Unix(), items[0].OccurrenceStart)
+		assert.Equal(t, items[0].OccurrenceStart, items[0].BeginDate)
+		assert.Equal(t, time.Date(2022, 1, 10, 12, 0, 0, 0, time.UTC).Unix(), items[0].EndDate)
+		assert.Equal(t, series.ID, items[0].ID)
+		assert.Equal(t, 1, len(items[0].ItemImages))
+		assert.Equal(t, single.Summary, items[1].Summary)
+		assert.Equal(t, int64(0), items[1].OccurrenceStart)
+		assert.Equal(t, time.Date(2022, 1, 17, 10, 0, 0, 0, time.UTC).Unix(), items[2].OccurrenceStart)
+		//paging walks the merged order
+		second, err := madden.GetMaddenItems(context.Background(), 1, 2, start, end, maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(second))
+		assert.Equal(t, items[2].OccurrenceStart, second[0].OccurrenceStart)
+		for _, sortField := range []maddendb.SortField{maddendb.StartDate, maddendb.EndDate} {
+			paged := collectItemPages(t, madden, 1, sortField)
+			assert.Equal(t, 6, len(paged))
+			assert.Equal(t, single.Summary, paged[2])
+		}
+		//the series itself is returned by id
+		stored, err := madden.GetMaddenItemById(context.Background(), series.ID)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, "FREQ=WEEKLY;COUNT=5", stored.RRule)
+		assert.Equal(t, int64(0), stored.OccurrenceStart)
+	})
+}
+
+func TestSetItemOccurrence(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		series := insertRecurringItem(t, madden, "FREQ=WEEKLY;COUNT=5")
+		second, third := time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC).Unix(), time.Date(2022, 1, 17, 10, 0, 0, 0, time.UTC).Unix()
+		moved := maddendb.ItemOccurrence{
+			OccurrenceStart: second,
+			BeginDate:       time.Date(2022, 1, 11, 9, 0, 0, 0, time.UTC).Unix(),
+			EndDate:         time.Date(2022, 1, 11, 13, 0, 0, 0, time.UTC).Unix(),
+			Summary:         "moved to tuesday this week",
+			Details:         series.Details,
+		}
+		updated, err := madden.SetMaddenItemOccurrence(context.Background(), series.ID, moved, series.Version, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, uint(2), updated.Version)
+		assert.Equal(t, 1, len(updated.Occurrences))
+		updated, err = madden.SetMaddenItemOccurrence(context.Background(), series.ID, maddendb.ItemOccurrence{OccurrenceStart: third, Cancelled: true}, updated.Version, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, uint(3), updated.Version)
+		assert.Equal(t, 2, len(updated.Occurrences))
+		//the series is untouched
+		assert.Equal(t, series.Summary, updated.Summary)
+		assert.Equal(t, series.BeginDate, updated.BeginDate)
+		items, err := madden.GetMaddenItems(context.Background(), 0, 10, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), maddendb.StartDate, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 4, len(items))
+		assert.Equal(t, second, items[1].OccurrenceStart)
+		assert.Equal(t, moved.BeginDate, items[1].BeginDate)
+		assert.Equal(t, moved.EndDate, items[1].EndDate)
+		assert.Equal(t, moved.Summary, items[1].Summary)
+		assert.Equal(t, time.Date(2022, 1, 24, 10, 0, 0, 0, time.UTC).Unix(), items[2].OccurrenceStart)
+		revisions, _ := madden.GetMaddenItemRevisions(context.Background(), series.ID)
+		assert.Equal(t, 3, len(revisions))
+		changes, _ := revisions[2].GetChanges()
+		assert.Equal(t, 1, len(changes))
+		assert.Equal(t, maddendb.FIELD_EXCEPTIONS, changes[0].Field)
+		//editing an occurrence again replaces the exception
+		moved.Summary = "moved to tuesday after all"
+		updated, err = madden.SetMaddenItemOccurrence(context.Background(), series.ID, moved, updated.Version, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 2, len(updated.Occurrences))
+		//occurrences the rule does not place are missing
+		_, err = madden.SetMaddenItemOccurrence(context.Background(), series.ID, maddendb.ItemOccurrence{OccurrenceStart: moved.BeginDate, Cancelled: true}, updated.Version, false, TEST_ACTOR)
+		notFound, ok := err.(*maddendb.DbError)
+		if !ok {
+			t.Errorf("expected db error on missing occurrence, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, true, errors.Is(notFound.OriginalError, gorm.ErrRecordNotFound))
+		_, err = madden.SetMaddenItemOccurrence(context.Background(), series.ID, maddendb.ItemOccurrence{OccurrenceStart: second, Cancelled: true}, series.Version, false, TEST_ACTOR)
+		if _, ok := err.(*maddendb.VersionConflictError); !ok {
+			t.Errorf("expected version conflict error on stale occurrence update, got %v\n", err)
+		}
+	})
+}
+
+func TestRecurringUpdateDropsStaleExceptions(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		series := insertRecurringItem(t, madden, "FREQ=WEEKLY;COUNT=5")
+		first, last := series.BeginDate, time.Date(2022, 1, 31, 10, 0, 0, 0, time.UTC).Unix()
+		updated, err := madden.SetMaddenItemOccurrence(context.Background(), series.ID, maddendb.ItemOccurrence{OccurrenceStart: first, Cancelled: true}, series.Version, false, TEST_ACTOR)
+		if err == nil {
+			updated, err = madden.SetMaddenItemOccurrence(context.Background(), series.ID, maddendb.ItemOccurrence{OccurrenceStart: last, Cancelled: true}, updated.Version, false, TEST_ACTOR)
+		}
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		//the fifth occurrence is no longer part of the series
+		updated.RRule = "FREQ=WEEKLY;COUNT=3"
+		updated, err = madden.UpdateMaddenItem(context.Background(), updated, updated.Version, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(updated.Occurrences))
+		assert.Equal(t, first, updated.Occurrences[0].OccurrenceStart)
+		assert.Equal(t, time.Date(2022, 1, 17, 12, 0, 0, 0, time.UTC).Unix(), updated.SeriesEnd)
+		//an invalid rule is rejected
+		updated.RRule = "FREQ=FORTNIGHTLY"
+		if _, err := madden.UpdateMaddenItem(context.Background(), updated, updated.Version, false, TEST_ACTOR); err == nil {
+			t.Errorf("expected error on invalid rule\n")
+		}
+	})
+}
+
+func TestHistoricizeRecurringItems(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		bounded := insertRecurringItem(t, madden, "FREQ=WEEKLY;COUNT=5")
+		unbounded := createDefaultItem()
+		unbounded.Summary = "weekly forever"
+		unbounded.BeginDate = time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC).Unix()
+		unbounded.EndDate = time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC).Unix()
+		unbounded.RRule = "FREQ=WEEKLY"
+		unbounded.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 2, Status: "FMC"}}
+		if _, err := madden.CreateMaddenItem(context.Background(), unbounded, false, TEST_ACTOR); err != nil {
+			t.Errorf("error on item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		//the first occurrence has ended but the series has not
+		run, _, err := madden.HistoricizeMaddenItems(context.Background(), time.Date(2022, 1, 20, 0, 0, 0, 0, time.UTC), "historicizer")
+		if err != nil {
+			t.Errorf("error historicizing ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, int64(0), run.Changed)
+		run, _, err = madden.HistoricizeMaddenItems(context.Background(), time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC), "historicizer")
+		if err != nil {
+			t.Errorf("error historicizing ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, int64(1), run.Changed)
+		stored, _ := madden.GetMaddenItemById(context.Background(), bounded.ID)
+		assert.Equal(t, true, stored.IsHistorical)
+	})
+}
+
+func TestItemConflicts(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		first := insertWindowItem(t, madden, "first window on image one", 10, 12, maddendb.ItemImages{MaddenImageFileId: 1, Status: "FMC"})
+		second := insertWindowItem(t, madden, "second window on image one", 11, 13, maddendb.ItemImages{MaddenImageFileId: 1, Status: "NMC"})
+		other := insertWindowItem(t, madden, "window on image two only", 11, 13, maddendb.ItemImages{MaddenImageFileId: 2, Status: "FMC"})
+		//starts as the first window ends so only overlaps the second
+		third := insertWindowItem(t, madden, "third window on image one", 12, 14, maddendb.ItemImages{MaddenImageFileId: 1, Status: "NMC"})
+		day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		conflicts, err := madden.GetMaddenItemConflicts(context.Background(), day.Unix(), day.AddDate(0, 0, 1).Unix())
+		if err != nil {
+			t.Errorf("error on conflict search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 2, len(conflicts))
+		assert.Equal(t, first.ID, conflicts[0].First.ID)
+		assert.Equal(t, second.ID, conflicts[0].Second.ID)
+		assert.Equal(t, []maddendb.ConflictImage{{MaddenImageFileId: 1, FirstStatus: "FMC", SecondStatus: "NMC"}}, conflicts[0].Images)
+		assert.Equal(t, true, conflicts[0].Contradictory())
+		assert.Equal(t, second.ID, conflicts[1].First.ID)
+		assert.Equal(t, third.ID, conflicts[1].Second.ID)
+		assert.Equal(t, false, conflicts[1].Contradictory())
+		//only windows inside the range are compared
+		later, err := madden.GetMaddenItemConflicts(context.Background(), day.Add(13*time.Hour).Unix(), day.AddDate(0, 0, 1).Unix())
+		if err != nil {
+			t.Errorf("error on conflict search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 0, len(later))
+		//a new item is checked against every item booking its images
+		candidate := createDefaultItem()
+		candidate.BeginDate = day.Add(11*time.Hour + 30*time.Minute).Unix()
+		candidate.EndDate = day.Add(11*time.Hour + 45*time.Minute).Unix()
+		candidate.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 1, Status: "PMC"}, {MaddenImageFileId: 2, Status: "PMC"}}
+		found, err := madden.FindMaddenItemConflicts(context.Background(), candidate)
+		if err != nil {
+			t.Errorf("error on conflict search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 3, len(found))
+		assert.Equal(t, uint(0), found[0].First.ID)
+		assert.Equal(t, first.ID, found[0].Second.ID)
+		assert.Equal(t, second.ID, found[1].Second.ID)
+		assert.Equal(t, other.ID, found[2].Second.ID)
+		//an update is not checked against the item it replaces
+		found, err = madden.FindMaddenItemConflicts(context.Background(), first)
+		if err != nil {
+			t.Errorf("error on conflict search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(found))
+		assert.Equal(t, second.ID, found[0].Second.ID)
+		//deleted items no longer conflict
+		if err := madden.DeleteMaddenItem(context.Background(), second.ID, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		conflicts, _ = madden.GetMaddenItemConflicts(context.Background(), day.Unix(), day.AddDate(0, 0, 1).Unix())
+		assert.Equal(t, 0, len(conflicts))
+	})
+}
+
+func TestRecurringItemConflicts(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		//occurrences every Monday from 2022-01-03 10:00 to 12:00 on image one
+		series := insertRecurringItem(t, madden, "FREQ=WEEKLY;COUNT=5")
+		single := insertWindowItem(t, madden, "overlaps the second occurrence", 9*24+11, 9*24+13, maddendb.ItemImages{MaddenImageFileId: 1, Status: "NMC"})
+		from, to := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC).Unix()
+		conflicts, err := madden.GetMaddenItemConflicts(context.Background(), from, to)
+		if err != nil {
+			t.Errorf("error on conflict search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(conflicts))
+		assert.Equal(t, series.ID, conflicts[0].First.ID)
+		assert.Equal(t, time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC).Unix(), conflicts[0].First.OccurrenceStart)
+		assert.Equal(t, single.ID, conflicts[0].Second.ID)
+		//a cancelled occurrence does not conflict
+		if _, err := madden.SetMaddenItemOccurrence(context.Background(), series.ID, maddendb.ItemOccurrence{OccurrenceStart: conflicts[0].First.OccurrenceStart, Cancelled: true}, series.Version, false, TEST_ACTOR); err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		conflicts, _ = madden.GetMaddenItemConflicts(context.Background(), from, to)
+		assert.Equal(t, 0, len(conflicts))
+		//a new series is checked across its occurrences
+		candidate := createDefaultItem()
+		candidate.BeginDate = time.Date(2022, 1, 4, 11, 0, 0, 0, time.UTC).Unix()
+		candidate.EndDate = time.Date(2022, 1, 4, 12, 0, 0, 0, time.UTC).Unix()
+		candidate.RRule = "FREQ=DAILY"
+		candidate.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 1, Status: "FMC"}}
+		found, err := madden.FindMaddenItemConflicts(context.Background(), candidate)
+		if err != nil {
+			t.Errorf("error on conflict search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		//the series on each remaining Monday and the single item on the 10th
+		assert.Equal(t, 4, len(found))
+		assert.Equal(t, time.Date(2022, 1, 10, 11, 0, 0, 0, time.UTC).Unix(), found[0].First.OccurrenceStart)
+		assert.Equal(t, single.ID, found[0].Second.ID)
+		assert.Equal(t, series.ID, found[1].Second.ID)
+		assert.Equal(t, time.Date(2022, 1, 17, 10, 0, 0, 0, time.UTC).Unix(), found[1].Second.OccurrenceStart)
+	})
+}
+
+func TestStrictWriteConflicts(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		booked := insertWindowItem(t, madden, "books image one", 10, 12, maddendb.ItemImages{MaddenImageFileId: 1, Status: "NMC"})
+		day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		candidate := createDefaultItem()
+		candidate.Summary = "overlaps the booking"
+		candidate.BeginDate = day.Add(11 * time.Hour).Unix()
+		candidate.EndDate = day.Add(13 * time.Hour).Unix()
+		candidate.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 1, Status: "PMC"}}
+		//a strict create is refused and nothing is written
+		_, err := madden.CreateMaddenItem(context.Background(), candidate, true, TEST_ACTOR)
+		overlap, ok := err.(*maddendb.OverlapError)
+		if !ok {
+			t.Errorf("expected overlap error on strict create, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(overlap.Conflicts))
+		assert.Equal(t, uint(0), overlap.Conflicts[0].First.ID)
+		assert.Equal(t, booked.ID, overlap.Conflicts[0].Second.ID)
+		conflicts, _ := madden.GetMaddenItemConflicts(context.Background(), day.Unix(), day.AddDate(0, 0, 1).Unix())
+		assert.Equal(t, 0, len(conflicts))
+		//otherwise the item is written listing its conflicts
+		created, err := madden.CreateMaddenItem(context.Background(), candidate, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(created.Conflicts))
+		assert.Equal(t, created.ID, created.Conflicts[0].First.ID)
+		assert.Equal(t, booked.ID, created.Conflicts[0].Second.ID)
+		//a strict update still conflicting is refused leaving the stored item alone
+		moved := created
+		moved.EndDate = day.Add(14 * time.Hour).Unix()
+		if _, err := madden.UpdateMaddenItem(context.Background(), moved, created.Version, true, TEST_ACTOR); err == nil {
+			t.Errorf("expected overlap error on strict update got nil\n")
+		} else if _, ok := err.(*maddendb.OverlapError); !ok {
+			t.Errorf("expected overlap error on strict update, got %v\n", err)
+		}
+		stored, _ := madden.GetMaddenItemById(context.Background(), created.ID)
+		assert.Equal(t, created.Version, stored.Version)
+		assert.Equal(t, candidate.EndDate, stored.EndDate)
+		//moving clear of the booking is allowed
+		moved.BeginDate = day.Add(12 * time.Hour).Unix()
+		updated, err := madden.UpdateMaddenItem(context.Background(), moved, created.Version, true, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 0, len(updated.Conflicts))
+		//a strict restore into a conflict is refused and the item stays deleted
+		if err := madden.DeleteMaddenItem(context.Background(), booked.ID, TEST_ACTOR); err != nil {
+			t.Errorf("expected nil error on delete got ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		blocking := insertWindowItem(t, madden, "books image one while deleted", 9, 11, maddendb.ItemImages{MaddenImageFileId: 1, Status: "FMC"})
+		_, err = madden.RestoreMaddenItem(context.Background(), booked.ID, true, TEST_ACTOR)
+		overlap, ok = err.(*maddendb.OverlapError)
+		if !ok {
+			t.Errorf("expected overlap error on strict restore, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, booked.ID, overlap.Conflicts[0].First.ID)
+		assert.Equal(t, blocking.ID, overlap.Conflicts[0].Second.ID)
+		if _, err := madden.GetMaddenItemById(context.Background(), booked.ID); err == nil {
+			t.Errorf("expected refused restore to leave the item deleted\n")
+		}
+		restored, err := madden.RestoreMaddenItem(context.Background(), booked.ID, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(restored.Conflicts))
+		assert.Equal(t, blocking.ID, restored.Conflicts[0].Second.ID)
+	})
+}
+
+func TestStrictOccurrenceConflicts(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		//occurrences every Monday from 2022-01-03 10:00 to 12:00 on image one
+		series := insertRecurringItem(t, madden, "FREQ=WEEKLY;COUNT=5")
+		//Tuesday 2022-01-11 from 10:00 to 12:00
+		single := insertWindowItem(t, madden, "books the Tuesday after the second occurrence", 10*24+10, 10*24+12, maddendb.ItemImages{MaddenImageFileId: 1, Status: "NMC"})
+		start := time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC)
+		occurrence := maddendb.ItemOccurrence{
+			OccurrenceStart: start.Unix(),
+			BeginDate:       start.Add(24 * time.Hour).Unix(),
+			EndDate:         start.Add(26 * time.Hour).Unix(),
+			Summary:         series.Summary,
+			Details:         series.Details,
+		}
+		//moving the occurrence onto the booking is refused
+		_, err := madden.SetMaddenItemOccurrence(context.Background(), series.ID, occurrence, series.Version, true, TEST_ACTOR)
+		overlap, ok := err.(*maddendb.OverlapError)
+		if !ok {
+			t.Errorf("expected overlap error on strict occurrence edit, got %v\n", err)
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(overlap.Conflicts))
+		assert.Equal(t, series.ID, overlap.Conflicts[0].First.ID)
+		assert.Equal(t, single.ID, overlap.Conflicts[0].Second.ID)
+		stored, _ := madden.GetMaddenItemById(context.Background(), series.ID)
+		assert.Equal(t, series.Version, stored.Version)
+		//otherwise it is written listing its conflict
+		updated, err := madden.SetMaddenItemOccurrence(context.Background(), series.ID, occurrence, series.Version, false, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 1, len(updated.Conflicts))
+		assert.Equal(t, start.Add(24*time.Hour).Unix(), updated.Conflicts[0].First.BeginDate)
+		//cancelling an occurrence never conflicts
+		cancel := maddendb.ItemOccurrence{OccurrenceStart: start.Unix(), Cancelled: true}
+		cancelled, err := madden.SetMaddenItemOccurrence(context.Background(), series.ID, cancel, updated.Version, true, TEST_ACTOR)
+		if err != nil {
+			t.Errorf("expected non error but got error: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 0, len(cancelled.Conflicts))
+	})
+}
+
+func TestConcurrentStrictCreates(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		attempts := 8
+		results := make(chan error, attempts)
+		var wait sync.WaitGroup
+		for i := 0; i < attempts; i++ {
+			item := createDefaultItem()
+			//distinct summaries so only the overlap can refuse a create
+			item.Summary = fmt.Sprintf("concurrent booking %d", i)
+			item.BeginDate = time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC).Unix()
+			item.EndDate = time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC).Unix()
+			item.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 1, Status: "NMC"}}
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				_, err := madden.CreateMaddenItem(context.Background(), item, true, TEST_ACTOR)
+				results <- err
+			}()
+		}
+		wait.Wait()
+		close(results)
+		created := 0
+		for err := range results {
+			if err == nil {
+				created++
+			} else if _, ok := err.(*maddendb.OverlapError); !ok {
+				t.Errorf("expected only overlap errors got ERROR: %s\n", err.Error())
+			}
+		}
+		assert.Equal(t, 1, created)
+	})
+}
+
+func TestMaddenStatus(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		partial := insertWindowItem(t, madden, "partial window on image one", 10, 12, maddendb.ItemImages{MaddenImageFileId: 1, Status: "PMC"})
+		down := insertWindowItem(t, madden, "down window on image one", 11, 13, maddendb.ItemImages{MaddenImageFileId: 1, Status: "NMC"})
+		insertWindowItem(t, madden, "partial window on image two", 12, 14, maddendb.ItemImages{MaddenImageFileId: 2, Status: "PMC"})
+		day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		at := day.Add(11*time.Hour + 30*time.Minute).Unix()
+		status, err := madden.GetMaddenStatus(context.Background(), at)
+		if err != nil {
+			t.Errorf("error on status ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, at, status.At)
+		assert.Equal(t, maddendb.STATUS_NMC, status.Status)
+		assert.Equal(t, uint(1), status.Images[0].MaddenImageFile.ID)
+		assert.Equal(t, maddendb.STATUS_NMC, status.Images[0].Status)
+		assert.Equal(t, 2, len(status.Images[0].Windows))
+		assert.Equal(t, partial.ID, status.Images[0].Windows[0].Item.ID)
+		assert.Equal(t, "PMC", status.Images[0].Windows[0].Status)
+		assert.Equal(t, down.ID, status.Images[0].Windows[1].Item.ID)
+		//images no active item books are FMC
+		for _, image := range status.Images[1:] {
+			assert.Equal(t, maddendb.STATUS_FMC, image.Status)
+			assert.Equal(t, 0, len(image.Windows))
+		}
+		//windows are active from their start up to their end
+		status, _ = madden.GetMaddenStatus(context.Background(), day.Add(13*time.Hour).Unix())
+		assert.Equal(t, maddendb.STATUS_PMC, status.Status)
+		assert.Equal(t, maddendb.STATUS_FMC, status.Images[0].Status)
+		assert.Equal(t, maddendb.STATUS_PMC, status.Images[1].Status)
+		status, _ = madden.GetMaddenStatus(context.Background(), day.Add(14*time.Hour).Unix())
+		assert.Equal(t, maddendb.STATUS_FMC, status.Status)
+		//recurring items count through their occurrences
+		insertRecurringItem(t, madden, "FREQ=WEEKLY;COUNT=5")
+		status, _ = madden.GetMaddenStatus(context.Background(), time.Date(2022, 1, 17, 11, 0, 0, 0, time.UTC).Unix())
+		assert.Equal(t, maddendb.STATUS_FMC, status.Status)
+		assert.Equal(t, 1, len(status.Images[0].Windows))
+		assert.Equal(t, time.Date(2022, 1, 17, 10, 0, 0, 0, time.UTC).Unix(), status.Images[0].Windows[0].Item.OccurrenceStart)
+	})
+}
+
+func TestWorseStatus(t *testing.T) {
+	assert.Equal(t, maddendb.STATUS_PMC, maddendb.WorseStatus(maddendb.STATUS_FMC, maddendb.STATUS_PMC))
+	assert.Equal(t, maddendb.STATUS_NMC, maddendb.WorseStatus(maddendb.STATUS_NMC, maddendb.STATUS_PMC))
+	assert.Equal(t, maddendb.STATUS_FMC, maddendb.WorseStatus(maddendb.STATUS_FMC, maddendb.STATUS_FMC))
+}
+
+func TestMaddenAvailability(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, madden maddendb.Madden) {
+		insertDefaultImages(t, madden)
+		insertWindowItem(t, madden, "partial window on image one", 10, 12, maddendb.ItemImages{MaddenImageFileId: 1, Status: "PMC"})
+		insertWindowItem(t, madden, "down window on image one", 11, 13, maddendb.ItemImages{MaddenImageFileId: 1, Status: "NMC"})
+		insertWindowItem(t, madden, "partial window on image two", 12, 14, maddendb.ItemImages{MaddenImageFileId: 2, Status: "PMC"})
+		insertWindowItem(t, madden, "first down window on image three", 1, 3, maddendb.ItemImages{MaddenImageFileId: 3, Status: "NMC"})
+		insertWindowItem(t, madden, "second down window on image three", 2, 4, maddendb.ItemImages{MaddenImageFileId: 3, Status: "NMC"})
+		insertWindowItem(t, madden, "window before the report", -5, -2, maddendb.ItemImages{MaddenImageFileId: 2, Status: "NMC"})
+		day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+		hour := int64(time.Hour / time.Second)
+		periods, err := madden.GetMaddenAvailability(context.Background(), []int64{day, day + 12*hour, day + 24*hour})
+		if err != nil {
+			t.Errorf("error on availability ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		assert.Equal(t, 2, len(periods))
+		assert.Equal(t, day, periods[0].Start)
+		assert.Equal(t, day+12*hour, periods[0].End)
+		assert.Equal(t, day+12*hour, periods[1].Start)
+		//overlapping windows count once with the worse status winning
+		first := periods[0].Images[0]
+		assert.Equal(t, uint(1), first.MaddenImageFile.ID)
+		assert.Equal(t, 10*hour, first.FMC)
+		assert.Equal(t, hour, first.PMC)
+		assert.Equal(t, hour, first.NMC)
+		assert.Equal(t, maddendb.ImageAvailability{MaddenImageFile: first.MaddenImageFile, FMC: 11 * hour, NMC: hour}, periods[1].Images[0])
+		assert.Equal(t, int64(0), periods[0].Images[1].PMC+periods[0].Images[1].NMC)
+		assert.Equal(t, 2*hour, periods[1].Images[1].PMC)
+		//windows of the same status are merged
+		assert.Equal(t, 3*hour, periods[0].Images[2].NMC)
+		assert.Equal(t, 9*hour, periods[0].Images[2].FMC)
+		//every period holds every image, each accounting for the whole period
+		for _, period := range periods {
+			assert.Equal(t, len(periods[0].Images), len(period.Images))
+			for _, image := range period.Images {
+				assert.Equal(t, period.End-period.Start, image.FMC+image.PMC+image.NMC)
+			}
+		}
+		//recurring items count through their occurrences
+		recurring := createDefaultItem()
+		recurring.BeginDate = time.Date(2022, 1, 3, 10, 0, 0, 0, time.UTC).Unix()
+		recurring.EndDate = time.Date(2022, 1, 3, 12, 0, 0, 0, time.UTC).Unix()
+		recurring.RRule = "FREQ=WEEKLY;COUNT=2"
+		recurring.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 4, Status: "NMC"}}
+		if _, err := madden.CreateMaddenItem(context.Background(), recurring, false, TEST_ACTOR); err != nil {
+			t.Errorf("error on recurring item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		week := time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC).Unix()
+		periods, _ = madden.GetMaddenAvailability(context.Background(), []int64{week, week + 7*24*hour, week + 14*24*hour, week + 21*24*hour})
+		assert.Equal(t, uint(4), periods[0].Images[3].MaddenImageFile.ID)
+		assert.Equal(t, 2*hour, periods[0].Images[3].NMC)
+		assert.Equal(t, 2*hour, periods[1].Images[3].NMC)
+		assert.Equal(t, int64(0), periods[2].Images[3].NMC)
+		if _, err := madden.GetMaddenAvailability(context.Background(), []int64{day}); err == nil {
+			t.Errorf("expected an error for a single boundary")
+		}
+		if _, err := madden.GetMaddenAvailability(context.Background(), []int64{day, day}); err == nil {
+			t.Errorf("expected an error for boundaries that do not ascend")
+		}
+	})
+}
+
+//Test helpers
+
+// awaitNotification fails the test unless a change event notification arrives on notify promptly
+func awaitNotification(t *testing.T, notify <-chan struct{}) {
+	select {
+	case <-notify:
+	case <-time.After(5 * time.Second):
+		t.Errorf("expected a change event notification\n")
+		t.FailNow()
+	}
+}
+
+// searchItems runs a search across all dates returning the results
+func searchItems(t *testing.T, madden maddendb.Madden, query string) []maddendb.SearchResult {
+	results, err := madden.SearchMaddenItems(context.Background(), query, 0, 10, time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2000, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), false)
+	if err != nil {
+		t.Errorf("error on item search ERROR: %s\n", err.Error())
+		t.FailNow()
+	}
+	return results
+}
+
+func insertSearchTestItems(t *testing.T, madden maddendb.Madden) {
+	insertDefaultImages(t, madden)
+	items := []maddendb.MaddenItem{
+		{Summary: "Hydraulic pump replacement", Details: "pump swapped on the north tower"},
+		{Summary: "Radar calibration window", Details: "check hydraulic lines during the outage"},
+		{Summary: "Network switch upgrade", Details: "firmware update"},
+	}
+	for i, item := range items {
+		item.ID = uint(i + 1)
+		item.BeginDate = time.Date(2022, 1, i+1, 0, 0, 0, 0, time.UTC).Unix()
+		item.EndDate = time.Date(2022, 1, i+2, 0, 0, 0, 0, time.UTC).Unix()
+		item.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: uint(i + 1), Status: "FMC"}}
+		if _, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR); err != nil {
+			t.Errorf("error on search item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+	}
+}
+
+// collectItemPages pages through every item with size items per page returning their summaries in order
+func collectItemPages(t *testing.T, madden maddendb.Madden, size int, sortField maddendb.SortField) []string {
+	summaries := []string{}
+	var cursor *maddendb.ItemCursor
+	for {
+		items, err := madden.GetMaddenItemsAfter(context.Background(), cursor, size, time.Date(2023, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), time.Date(2000, 1, 1, 1, 1, 1, 1, time.UTC).Unix(), sortField, false)
+		if err != nil {
+			t.Errorf("error on item search ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+		if len(items) == 0 {
+			return summaries
+		}
+		for _, item := range items {
+			summaries = append(summaries, item.Summary)
+		}
+		next := maddendb.NewItemCursor(items[len(items)-1], sortField)
+		cursor = &next
+	}
+}
+
+//importTestRecords returns an import of two images, two items linking them, a summary and a published state
+//the items link images by the ids of the import, thumbnail and status vary the first image and item
+func importTestRecords(thumbnail, status string) []maddendb.ImportRecord {
+	first := maddendb.MaddenImageFile{Model: gorm.Model{ID: 10}, FileName: "f1", Thumbnail: thumbnail}
+	second := maddendb.MaddenImageFile{Model: gorm.Model{ID: 11}, FileName: "f2", Thumbnail: "t2"}
+	firstItem := maddendb.MaddenItem{
+		Summary:    "Imported1",
+		Details:    "Details1",
+		BeginDate:  time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+		EndDate:    time.Date(2022, 2, 2, 2, 2, 2, 2, time.UTC).Unix(),
+		ItemImages: []maddendb.ItemImages{{Status: status, MaddenImageFileId: 10}},
+	}
+	secondItem := maddendb.MaddenItem{
+		Summary:    "Imported2",
+		Details:    "Details2",
+		BeginDate:  time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+		EndDate:    time.Date(2021, 2, 2, 2, 2, 2, 2, time.UTC).Unix(),
+		ItemImages: []maddendb.ItemImages{{Status: "FMC", MaddenImageFileId: 10}, {Status: "NMC", MaddenImageFileId: 11}},
+	}
+	return []maddendb.ImportRecord{
+		{Line: 1, Image: &first},
+		{Line: 2, Image: &second},
+		{Line: 3, Item: &firstItem},
+		{Line: 4, Item: &secondItem},
+		{Line: 5, Summary: &maddendb.Summary{Summary: "imported summary"}},
+		{Line: 6, Published: &maddendb.Published{Published: true}},
+	}
+}
+
+func createDefaultItem() maddendb.MaddenItem {
+	t1 := time.Now().UTC().Unix()
+	t2 := time.Now().UTC().Unix()
+	return maddendb.MaddenItem{BeginDate: t1, EndDate: t2, Summary: "Im a summary", Details: "these are details"}
+}
+
+// insertWindowItem inserts an item booking itemImage from the hour begin to the hour end counted from 2022-01-01 00:00 UTC
+func insertWindowItem(t *testing.T, madden maddendb.Madden, summary string, begin, end int, itemImage maddendb.ItemImages) maddendb.MaddenItem {
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	item := createDefaultItem()
+	item.Summary = summary
+	item.BeginDate = day.Add(time.Duration(begin) * time.Hour).Unix()
+	item.EndDate = day.Add(time.Duration(end) * time.Hour).Unix()
+	item.ItemImages = []maddendb.ItemImages{itemImage}
+	inserted, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+	if err != nil {
+		t.Errorf("error on item insert ERROR: %s\n", err.Error())
+		t.FailNow()
+	}
+	return inserted
+}
+
+// insertRecurringItem inserts a two hour entry recurring by rule from Monday 2022-01-03 10:00 UTC
+func insertRecurringItem(t *testing.T, madden maddendb.Madden, rule string) maddendb.MaddenItem {
+	item := createDefaultItem()
+	item.Summary = "weekly maintenance window"
+	item.BeginDate = time.Date(2022, 1, 3, 10, 0, 0, 0, time.UTC).Unix()
+	item.EndDate = time.Date(2022, 1, 3, 12, 0, 0, 0, time.UTC).Unix()
+	item.RRule = rule
+	item.ItemImages = []maddendb.ItemImages{{MaddenImageFileId: 1, Status: "FMC"}}
+	inserted, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR)
+	if err != nil {
+		t.Errorf("error on recurring item insert ERROR: %s\n", err.Error())
+		t.FailNow()
+	}
+	return inserted
+}
+
+func insertDefaultImages(t *testing.T, madden maddendb.Madden) {
+	images := []maddendb.MaddenImageFile{
+		{
+			FileName:  "f1",
+			Model:     gorm.Model{ID: 1},
+			Thumbnail: "t1",
+		},
+		{
+			FileName:  "f2",
+			Model:     gorm.Model{ID: 2},
+			Thumbnail: "t2",
+		},
+		{
+			FileName:  "f3",
+			Model:     gorm.Model{ID: 3},
+			Thumbnail: "t3",
+		},
+		{
+			FileName:  "f4",
+			Model:     gorm.Model{ID: 4},
+			Thumbnail: "t4",
+		},
+		{
+			FileName:  "f5",
+			Model:     gorm.Model{ID: 5},
+			Thumbnail: "t5",
+		},
+	}
+	for _, image := range images {
+		if _, err := madden.CreateMaddenImage(context.Background(), image); err != nil {
+			t.Errorf("error on image insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+	}
+}
+
+func insertDefaultItems(t *testing.T, madden maddendb.Madden) {
+	insertDefaultImages(t, madden)
+	items := []maddendb.MaddenItem{
+		{
+			Summary:   "Item1",
+			Details:   "Details1",
+			BeginDate: time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+			EndDate:   time.Date(2022, 2, 2, 2, 2, 2, 2, time.UTC).Unix(),
+
+			Model: gorm.Model{ID: 1},
+			ItemImages: []maddendb.ItemImages{
+				{
+					Status:          "FMC",
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 1}},
+				},
+			},
+		},
+		{
+			Summary:   "Item2",
+			Details:   "Details2",
+			BeginDate: time.Date(2021, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+			EndDate:   time.Date(2021, 2, 2, 2, 2, 2, 2, time.UTC).Unix(),
+			ItemImages: []maddendb.ItemImages{
+				{
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 2}},
+					Status:          "FMC",
+				},
+			},
+			Model: gorm.Model{ID: 2},
+		},
+		{
+			Summary:   "Item3",
+			Details:   "Details3",
+			BeginDate: time.Date(2020, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+			EndDate:   time.Date(2020, 2, 2, 2, 2, 2, 2, time.UTC).Unix(),
+			ItemImages: []maddendb.ItemImages{
+				{
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 3}},
+					Status:          "FMC",
+				},
+			},
+			Model: gorm.Model{ID: 3},
+		},
+
+		{
+			Summary:   "Item4",
+			Details:   "Details4",
+			BeginDate: time.Date(2019, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+			EndDate:   time.Date(2019, 2, 2, 2, 2, 2, 2, time.UTC).Unix(),
+			ItemImages: []maddendb.ItemImages{
+				{
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 4}},
+					Status:          "FMC",
+				},
+				{
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 5}},
+					Status:          "FMC",
+				},
+			},
+			Model: gorm.Model{ID: 4},
+		},
+	}
+
+	for _, item := range items {
+		if _, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR); err != nil {
+			t.Errorf("error on default item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+	}
+}
+
+// This is a slightly different set of items used to test if it's sorting correctly
+func insertSortTestItems(t *testing.T, madden maddendb.Madden) {
+	insertDefaultImages(t, madden)
+	items := []maddendb.MaddenItem{
+		{
+			Summary:   "Item1",
+			Details:   "Details1",
+			BeginDate: time.Date(2022, 2, 1, 1, 1, 1, 1, time.UTC).Unix(),
+			EndDate:   time.Date(2022, 2, 1, 1, 1, 1, 1, time.UTC).Unix(),
+
+			Model: gorm.Model{ID: 1},
+			ItemImages: []maddendb.ItemImages{
+				{
+					Status:          "FMC",
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 1}},
+				},
+			},
+		},
+		{
+			Summary:   "Item2",
+			Details:   "Details2",
+			BeginDate: time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+			EndDate:   time.Date(2022, 1, 3, 1, 1, 1, 1, time.UTC).Unix(),
+			ItemImages: []maddendb.ItemImages{
+				{
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 2}},
+					Status:          "FMC",
+				},
+			},
+			Model: gorm.Model{ID: 2},
+		},
+		{
+			Summary:   "Item3",
+			Details:   "Details3",
+			BeginDate: time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+			EndDate:   time.Date(2022, 1, 2, 1, 1, 1, 1, time.UTC).Unix(),
+			ItemImages: []maddendb.ItemImages{
+				{
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 3}},
+					Status:          "FMC",
+				},
+			},
+			Model: gorm.Model{ID: 3},
+		},
+
+		{
+			Summary:   "Item4",
+			Details:   "Details4",
+			BeginDate: time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC).Unix(),
+			EndDate:   time.Date(2022, 2, 2, 2, 2, 2, 2, time.UTC).Unix(),
+			ItemImages: []maddendb.ItemImages{
+				{
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 4}},
+					Status:          "FMC",
+				},
+				{
+					MaddenImageFile: maddendb.MaddenImageFile{Model: gorm.Model{ID: 5}},
+					Status:          "FMC",
+				},
+			},
+			Model: gorm.Model{ID: 4},
+		},
+	}
+
+	for _, item := range items {
+		if _, err := madden.CreateMaddenItem(context.Background(), item, false, TEST_ACTOR); err != nil {
+			t.Errorf("error on default item insert ERROR: %s\n", err.Error())
+			t.FailNow()
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"../services/maddendb"
+	"github.com/go-playground/assert/v2"
+)
+
+//embedded migrations are validated without a database
+
+func TestMigrationsLoad(t *testing.T) {
+	migrations, err := maddendb.LoadMigrations()
+	if err != nil {
+		t.Errorf("expected embedded migrations to load but got ERROR: %s\n", err.Error())
+		t.FailNow()
+	}
+	if len(migrations) < 1 {
+		t.Errorf("expected at least one migration")
+		t.FailNow()
+	}
+	for i, migration := range migrations {
+		assert.Equal(t, uint(i+1), migration.Version)
+		assert.NotEqual(t, "", strings.TrimSpace(migration.Up))
+		assert.NotEqual(t, "", strings.TrimSpace(migration.Down))
+	}
+	assert.Equal(t, "initial_schema", migrations[0].Name)
+}
@@ -0,0 +1,222 @@
+package maddendb
+
+import (
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+//defines bulk import of madden data, records are matched to existing data the same way for every data store
+
+type ImportMode int
+
+const (
+	//ImportSkip leaves existing data matching a record unchanged
+	ImportSkip ImportMode = 0
+	//ImportUpsert updates existing data matching a record
+	ImportUpsert ImportMode = 1
+)
+
+type importOutcome int
+
+const (
+	importCreated importOutcome = iota
+	importUpdated
+	importSkipped
+)
+
+//ImportRecord is a single record of an import, exactly one of Image, Item, Summary and Published is set
+//item images link images by the ID of an earlier image record of the same import, not by a stored id
+type ImportRecord struct {
+	//line of the import holding the record, reported with any error
+	Line      int
+	Image     *MaddenImageFile
+	Item      *MaddenItem
+	Summary   *Summary
+	Published *Published
+}
+
+//ImportLineError describes a record that could not be imported
+type ImportLineError struct {
+	Line    int
+	Message string
+}
+
+//ImportResult counts the records of an import by outcome, nothing is written if Errors is not empty
+type ImportResult struct {
+	Created int
+	Updated int
+	Skipped int
+	Errors  []ImportLineError
+}
+
+//importStore is the data store an import is applied to, the same transaction backs every call
+type importStore interface {
+	//findImageByName returns the image with fileName including deleted images, nil if there is none
+	findImageByName(fileName string) (*MaddenImageFile, error)
+	createImage(image MaddenImageFile) (MaddenImageFile, error)
+	updateImageThumbnail(id uint, thumbnail string) error
+	//findDuplicateItem returns the id of the live item identical to item, 0 if there is none
+	findDuplicateItem(item MaddenItem) (uint, error)
+	getItem(id uint) (MaddenItem, error)
+	createItem(item MaddenItem, actor string) (MaddenItem, error)
+	updateItem(item MaddenItem, expectedVersion uint, actor string) (MaddenItem, error)
+	currentSummary() (Summary, error)
+	createSummary(summary Summary) error
+	currentPublished() (Published, error)
+	createPublished(published Published) error
+}
+
+//importer applies the records of a single import, mapping the image ids of the import to stored ids
+type importer struct {
+	mode     ImportMode
+	actor    string
+	imageIds map[uint]uint
+	result   ImportResult
+}
+
+func newImporter(mode ImportMode, actor string) *importer {
+	return &importer{mode: mode, actor: actor, imageIds: map[uint]uint{}, result: ImportResult{Errors: []ImportLineError{}}}
+}
+
+//apply applies record to store returning its outcome, the error is returned so the caller may undo a partial write
+func (imp *importer) apply(store importStore, record ImportRecord) (importOutcome, error) {
+	switch {
+	case record.Image != nil:
+		return imp.applyImage(store, *record.Image)
+	case record.Item != nil:
+		return imp.applyItem(store, *record.Item)
+	case record.Summary != nil:
+		current, err := store.currentSummary()
+		if err != nil {
+			return importSkipped, err
+		}
+		if current.ID != 0 && current.Summary == record.Summary.Summary {
+			return importSkipped, nil
+		}
+		return importCreated, store.createSummary(Summary{Summary: record.Summary.Summary})
+	case record.Published != nil:
+		current, err := store.currentPublished()
+		if err != nil {
+			return importSkipped, err
+		}
+		if current.ID != 0 && current.Published == record.Published.Published {
+			return importSkipped, nil
+		}
+		return importCreated, store.createPublished(Published{Published: record.Published.Published})
+	}
+	return importSkipped, fmt.Errorf("record has no content")
+}
+
+//count records the outcome of applying the record on line
+func (imp *importer) count(line int, outcome importOutcome, err error) {
+	if err != nil {
+		imp.result.Errors = append(imp.result.Errors, ImportLineError{Line: line, Message: importErrorMessage(err)})
+		return
+	}
+	switch outcome {
+	case importCreated:
+		imp.result.Created++
+	case importUpdated:
+		imp.result.Updated++
+	default:
+		imp.result.Skipped++
+	}
+}
+
+//applyImage matches image to a stored image by file name, which is unique including deleted images
+func (imp *importer) applyImage(store importStore, image MaddenImageFile) (importOutcome, error) {
+	existing, err := store.findImageByName(image.FileName)
+	if err != nil {
+		return importSkipped, err
+	}
+	if existing == nil {
+		insertable := image
+		insertable.Model = gorm.Model{}
+		created, err := store.createImage(insertable)
+		if err != nil {
+			return importSkipped, err
+		}
+		imp.imageIds[image.ID] = created.ID
+		return importCreated, nil
+	}
+	if existing.DeletedAt.Valid {
+		return importSkipped, fmt.Errorf("image %s is in the trash", image.FileName)
+	}
+	outcome := importSkipped
+	if imp.mode == ImportUpsert && image.Thumbnail != "" && image.Thumbnail != existing.Thumbnail {
+		if err := store.updateImageThumbnail(existing.ID, image.Thumbnail); err != nil {
+			return importSkipped, err
+		}
+		outcome = importUpdated
+	}
+	imp.imageIds[image.ID] = existing.ID
+	return outcome, nil
+}
+
+//applyItem matches item to a stored item by the identical item rule that refuses duplicate creates
+//an upsert replaces the historical flag and images of the match, the only fields that can differ
+func (imp *importer) applyItem(store importStore, item MaddenItem) (importOutcome, error) {
+	insertable := item
+	insertable.Model = gorm.Model{}
+	insertable.ItemImages = []ItemImages{}
+	for _, itemImage := range item.ItemImages {
+		imageId, found := imp.imageIds[itemImageFileId(itemImage)]
+		if !found {
+			return importSkipped, fmt.Errorf("image %d is not an image record of the import", itemImageFileId(itemImage))
+		}
+		insertable.ItemImages = append(insertable.ItemImages, ItemImages{Status: itemImage.Status, MaddenImageFileId: imageId})
+	}
+	existingId, err := store.findDuplicateItem(insertable)
+	if err != nil {
+		return importSkipped, err
+	}
+	if existingId == 0 {
+		_, err := store.createItem(insertable, imp.actor)
+		return importCreated, err
+	}
+	if imp.mode == ImportSkip {
+		return importSkipped, nil
+	}
+	existing, err := store.getItem(existingId)
+	if err != nil {
+		return importSkipped, err
+	}
+	if existing.IsHistorical == insertable.IsHistorical && sameItemImages(existing.ItemImages, insertable.ItemImages) {
+		return importSkipped, nil
+	}
+	insertable.ID = existing.ID
+	_, err = store.updateItem(insertable, existing.Version, imp.actor)
+	return importUpdated, err
+}
+
+//sameItemImages returns true if both sets of item images link the same images with the same statuses
+func sameItemImages(a, b []ItemImages) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := func(itemImages []ItemImages) []string {
+		linked := []string{}
+		for _, itemImage := range itemImages {
+			linked = append(linked, fmt.Sprintf("%d:%s", itemImageFileId(itemImage), itemImage.Status))
+		}
+		sort.Strings(linked)
+		return linked
+	}
+	first, second := keys(a), keys(b)
+	for i := range first {
+		if first[i] != second[i] {
+			return false
+		}
+	}
+	return true
+}
+
+//importErrorMessage describes err for an import line, including the underlying cause of a DbError
+func importErrorMessage(err error) string {
+	if converted, ok := err.(*DbError); ok && converted.OriginalError != nil {
+		return fmt.Sprintf("%s: %s", converted.Message, converted.OriginalError.Error())
+	}
+	return err.Error()
+}
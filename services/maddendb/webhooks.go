@@ -0,0 +1,162 @@
+package maddendb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+//defines webhook change events and the outbox deliveries announcing them
+
+const (
+	EVENT_ENTRY_CREATED     = "entry.created"
+	EVENT_ENTRY_UPDATED     = "entry.updated"
+	EVENT_ENTRY_DELETED     = "entry.deleted"
+	EVENT_ENTRY_RESTORED    = "entry.restored"
+	EVENT_SUMMARY_CHANGED   = "summary.changed"
+	EVENT_PUBLISHED_CHANGED = "published.changed"
+
+	DELIVERY_PENDING   = "pending"
+	DELIVERY_DELIVERED = "delivered"
+	DELIVERY_DEAD      = "dead"
+
+	//separates the event types of a subscription
+	WEBHOOK_EVENT_SEPARATOR = ","
+)
+
+var (
+	//every event type a subscription may name
+	WebhookEvents = []string{EVENT_ENTRY_CREATED, EVENT_ENTRY_UPDATED, EVENT_ENTRY_DELETED, EVENT_ENTRY_RESTORED, EVENT_SUMMARY_CHANGED, EVENT_PUBLISHED_CHANGED}
+	//the entry event announcing each revision action
+	revisionEvents = map[string]string{
+		REVISION_CREATE:  EVENT_ENTRY_CREATED,
+		REVISION_UPDATE:  EVENT_ENTRY_UPDATED,
+		REVISION_DELETE:  EVENT_ENTRY_DELETED,
+		REVISION_RESTORE: EVENT_ENTRY_RESTORED,
+	}
+)
+
+//WebhookPayload is the json body posted for an event
+type WebhookPayload struct {
+	//identifies the event, it is the same for the delivery to every subscription and for every attempt
+	Id         string      `json:"id"`
+	Event      string      `json:"event"`
+	OccurredAt time.Time   `json:"occurredAt"`
+	Data       interface{} `json:"data"`
+}
+
+//EntryEventData is the data of an entry event, entry and changes match the revision recorded with the change
+type EntryEventData struct {
+	Id       uint          `json:"id"`
+	Revision uint          `json:"revision"`
+	Version  uint          `json:"version"`
+	Actor    string        `json:"actor"`
+	Entry    ItemSnapshot  `json:"entry"`
+	Changes  []FieldChange `json:"changes"`
+}
+
+//SummaryEventData is the data of a summary event
+type SummaryEventData struct {
+	Summary string `json:"summary"`
+}
+
+//PublishedEventData is the data of a published event
+type PublishedEventData struct {
+	Published bool `json:"published"`
+}
+
+//ValidWebhookEvent returns true if event is one of WebhookEvents
+func ValidWebhookEvent(event string) bool {
+	for _, known := range WebhookEvents {
+		if event == known {
+			return true
+		}
+	}
+	return false
+}
+
+//GetEvents returns the event types delivered to the subscription, empty if every event type is delivered
+func (subscription WebhookSubscription) GetEvents() []string {
+	if subscription.Events == "" {
+		return []string{}
+	}
+	return strings.Split(subscription.Events, WEBHOOK_EVENT_SEPARATOR)
+}
+
+//SetEvents sets the event types delivered to the subscription, every event type is delivered if events is empty
+func (subscription *WebhookSubscription) SetEvents(events []string) {
+	subscription.Events = strings.Join(events, WEBHOOK_EVENT_SEPARATOR)
+}
+
+//subscribed returns true if event is delivered to the subscription
+func (subscription WebhookSubscription) subscribed(event string) bool {
+	events := subscription.GetEvents()
+	if len(events) == 0 {
+		return true
+	}
+	for _, subscribed := range events {
+		if subscribed == event {
+			return true
+		}
+	}
+	return false
+}
+
+//entryPayload builds the payload announcing revision of item
+func entryPayload(item MaddenItem, revision ItemRevision) (WebhookPayload, error) {
+	snapshot, err := revision.GetSnapshot()
+	if err != nil {
+		return WebhookPayload{}, err
+	}
+	changes, err := revision.GetChanges()
+	if err != nil {
+		return WebhookPayload{}, err
+	}
+	return WebhookPayload{
+		Id:         fmt.Sprintf("entry-%d-revision-%d", item.ID, revision.Revision),
+		Event:      revisionEvents[revision.Action],
+		OccurredAt: revision.CreatedAt.UTC(),
+		Data:       EntryEventData{Id: item.ID, Revision: revision.Revision, Version: item.Version, Actor: revision.Actor, Entry: snapshot, Changes: changes},
+	}, nil
+}
+
+func summaryPayload(summary Summary) WebhookPayload {
+	return WebhookPayload{
+		Id:         fmt.Sprintf("summary-%d", summary.ID),
+		Event:      EVENT_SUMMARY_CHANGED,
+		OccurredAt: summary.CreatedAt.UTC(),
+		Data:       SummaryEventData{Summary: summary.Summary},
+	}
+}
+
+func publishedPayload(published Published) WebhookPayload {
+	return WebhookPayload{
+		Id:         fmt.Sprintf("published-%d", published.ID),
+		Event:      EVENT_PUBLISHED_CHANGED,
+		OccurredAt: published.CreatedAt.UTC(),
+		Data:       PublishedEventData{Published: published.Published},
+	}
+}
+
+//buildDeliveries returns a pending delivery of payload, due immediately, for each subscription to its event
+func buildDeliveries(subscriptions []WebhookSubscription, payload WebhookPayload) ([]WebhookDelivery, error) {
+	deliveries := []WebhookDelivery{}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return deliveries, &DbError{Message: "error encoding webhook payload", OriginalError: err}
+	}
+	for _, subscription := range subscriptions {
+		if !subscription.subscribed(payload.Event) {
+			continue
+		}
+		deliveries = append(deliveries, WebhookDelivery{
+			WebhookSubscriptionId: subscription.ID,
+			Event:                 payload.Event,
+			Payload:               string(encoded),
+			Status:                DELIVERY_PENDING,
+			NextAttemptAt:         payload.OccurredAt,
+		})
+	}
+	return deliveries, nil
+}
@@ -1,5 +1,7 @@
 package models
 
+import "net/http"
+
 //DataServiceError defines a standard error type for any data service
 type DataServiceError struct {
 	Message string
@@ -22,3 +24,53 @@ func (err DataServiceError) Error() string {
 func (err DataServiceError) ErrorCode() int {
 	return err.Code
 }
+
+//ConflictError is returned when a write collides with an existing record, ExistingId identifies that record
+type ConflictError struct {
+	Message    string
+	Code       int
+	ExistingId uint
+}
+
+//NewConflictError returns a conflict error with the passed message referencing the existing record
+func NewConflictError(message string, existingId uint) error {
+	return ConflictError{
+		Message:    message,
+		Code:       http.StatusConflict,
+		ExistingId: existingId,
+	}
+}
+
+func (err ConflictError) Error() string {
+	return err.Message
+}
+
+//ErrorCode returns the error code associated with this conflict error
+func (err ConflictError) ErrorCode() int {
+	return err.Code
+}
+
+//ImageInUseError is returned when an image can not be removed because entries still use it
+type ImageInUseError struct {
+	Message  string
+	Code     int
+	EntryIds []uint
+}
+
+//NewImageInUseError returns an image in use error with the passed message listing the entries using the image
+func NewImageInUseError(message string, entryIds []uint) error {
+	return ImageInUseError{
+		Message:  message,
+		Code:     http.StatusConflict,
+		EntryIds: entryIds,
+	}
+}
+
+func (err ImageInUseError) Error() string {
+	return err.Message
+}
+
+//ErrorCode returns the error code associated with this image in use error
+func (err ImageInUseError) ErrorCode() int {
+	return err.Code
+}
@@ -15,6 +15,10 @@ func StatusCodeError(err error) int {
 	switch errorType := err.(type) {
 	case models.DataServiceError:
 		return errorType.Code
+	case models.ConflictError:
+		return errorType.Code
+	case models.ImageInUseError:
+		return errorType.Code
 	default:
 		if code := seeIfCodeFieldExists(err); code != nil {
 			return *code